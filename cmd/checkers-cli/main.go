@@ -0,0 +1,340 @@
+// Plays checkers in the terminal against another person or the engine, without needing Discord
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jmsheff/discord-checkers/endgame"
+	"github.com/jmsheff/discord-checkers/engine"
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/pdn"
+)
+
+// Players, the same as the bot uses
+const (
+	BLUE uint8 = 1
+	RED  uint8 = 2
+)
+
+// ANSI escape codes used to draw the board
+const (
+	RESET       = "\033[0m"
+	LIGHT       = "\033[48;5;180m"
+	DARK        = "\033[48;5;94m"
+	RED_PIECE   = "\033[1;91m"
+	BLUE_PIECE  = "\033[1;94m"
+	SQUARE_TEXT = "\033[2;37m"
+	HIGHLIGHT   = "\033[48;5;58m"
+)
+
+// A game being played and every position it went through so moves can be undone
+type session struct {
+	Start   logic.Game   // The position the game started from
+	Game    logic.Game   // The current position
+	History []logic.Game // The position before each move
+	Moves   []string     // Every move in standard notation
+	Engine  map[uint8]bool
+	Color   bool // If ANSI colors are used
+}
+
+func main() {
+	variant := flag.String("variant", logic.Variants[0].Name(), "variant to play")
+	fen := flag.String("fen", "", "position to start from")
+	against := flag.String("engine", "", "color the engine plays, red, blue, both or empty for two people")
+	depth := flag.Int("depth", engine.DEFAULT.Depth, "maximum depth the engine searches")
+	moveTime := flag.Duration("movetime", engine.DEFAULT.Time, "time the engine searches each move for")
+	tablebases := flag.String("tablebases", "", "directory with endgame tablebases")
+	plain := flag.Bool("plain", false, "draw the board without colors")
+	flag.Parse()
+
+	r, err := logic.GetRules(*variant)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s := &session{Engine: make(map[uint8]bool), Color: !*plain}
+	switch strings.ToLower(*against) {
+	case "":
+	case "red":
+		s.Engine[RED] = true
+	case "blue":
+		s.Engine[BLUE] = true
+	case "both":
+		s.Engine[RED], s.Engine[BLUE] = true, true
+	default:
+		log.Fatal("Invalid engine color " + *against)
+	}
+
+	start := logic.NewGame(r)
+	if *fen != "" {
+		if start, err = logic.ParseFEN(*fen, r); err != nil {
+			log.Fatal(err)
+		}
+	}
+	s.reset(start)
+
+	config := engine.Config{Depth: *depth, Time: *moveTime}
+	if *tablebases != "" {
+		databases, err := endgame.LoadDir(*tablebases)
+		if err != nil {
+			log.Fatal(err)
+		}
+		config.Tablebase = databases
+	}
+	e := engine.New(config)
+
+	fmt.Println(r.Title() + ". Type a move like 11-15 or help for every command.")
+	s.print()
+
+	input := bufio.NewScanner(os.Stdin)
+	for {
+		// Let the engine play until it is a persons turn or the game is over
+		for s.Engine[s.Game.Turn] && logic.GetWinner(&s.Game) == 0 {
+			result, err := e.BestMove(s.Game)
+			if err != nil {
+				fmt.Println(err)
+				break
+			}
+			fmt.Println(colorName(s.Game.Turn) + " plays " + logic.FormatSequence(result.Move, &s.Game))
+			s.play(result.Move)
+			s.print()
+		}
+
+		fmt.Print(colorName(s.Game.Turn) + "> ")
+		if !input.Scan() {
+			fmt.Println()
+			return
+		}
+		cmd := strings.Fields(input.Text())
+		if len(cmd) == 0 {
+			continue
+		}
+
+		switch strings.ToLower(cmd[0]) {
+		case "quit", "exit":
+			return
+		case "help":
+			printHelp()
+		case "board":
+			s.print()
+		case "moves":
+			var moves []string
+			for _, seq := range logic.GetSequences(&s.Game) {
+				moves = append(moves, logic.FormatSequence(seq, &s.Game))
+			}
+			fmt.Println(strings.Join(moves, " "))
+		case "undo":
+			if !s.undo() {
+				fmt.Println("There are no moves to undo")
+				continue
+			}
+			// Take back the engines move too so it is the persons turn again
+			for s.Engine[s.Game.Turn] && !s.Engine[3-s.Game.Turn] && s.undo() {
+			}
+			s.print()
+		case "new":
+			s.reset(logic.NewGame(r))
+			s.print()
+		case "fen":
+			if len(cmd) == 1 {
+				fmt.Println(logic.FormatFEN(&s.Game))
+				continue
+			}
+			game, err := logic.ParseFEN(strings.Join(cmd[1:], " "), r)
+			if err != nil {
+				fmt.Println(err)
+				continue
+			}
+			s.reset(game)
+			s.print()
+		case "save":
+			if len(cmd) != 2 {
+				fmt.Println("Type save followed by the file to write the game to")
+				continue
+			}
+			if err := s.save(cmd[1]); err != nil {
+				fmt.Println(err)
+				continue
+			}
+			fmt.Println("Saved the game to " + cmd[1])
+		default:
+			if logic.GetWinner(&s.Game) != 0 {
+				fmt.Println("The game is over, type new to start again or undo to take back moves")
+				continue
+			}
+			seq, err := logic.ParseSequence(cmd[0], &s.Game)
+			if err != nil {
+				fmt.Println(err)
+				continue
+			}
+			s.play(seq)
+			s.print()
+		}
+	}
+}
+
+// Prints every command
+func printHelp() {
+	fmt.Println(`Commands:
+  11-15, 15x22  Play a move in standard notation, captures can leave out the squares in between
+  moves         List every legal move
+  undo          Take back the last move, and the engines reply when playing the engine
+  board         Draw the board again
+  fen [FEN]     Print the position as FEN or load a position
+  save <file>   Save the game as PDN
+  new           Start a new game
+  quit          Leave`)
+}
+
+// Gets the name of a players color
+func colorName(player uint8) string {
+	if player == RED {
+		return "Red"
+	}
+	return "Blue"
+}
+
+// Starts the game again from a position
+func (s *session) reset(game logic.Game) {
+	s.Start, s.Game = game, game
+	s.History, s.Moves = nil, nil
+}
+
+// Plays a move and remembers the position before it
+func (s *session) play(seq logic.Sequence) {
+	s.History = append(s.History, s.Game)
+	s.Moves = append(s.Moves, logic.FormatSequence(seq, &s.Game))
+	logic.ApplySequence(seq, &s.Game)
+	logic.SwapTurn(&s.Game)
+}
+
+// Takes back the last move, returns false if no moves have been played
+func (s *session) undo() bool {
+	if len(s.History) == 0 {
+		return false
+	}
+	s.Game = s.History[len(s.History)-1]
+	s.History = s.History[:len(s.History)-1]
+	s.Moves = s.Moves[:len(s.Moves)-1]
+	return true
+}
+
+// Writes the game to a PDN file
+func (s *session) save(path string) error {
+	r := s.Game.Rules()
+	white, black := "Player", "Player"
+	if s.Engine[logic.White(r)] {
+		white = "Engine"
+	}
+	if s.Engine[3-logic.White(r)] {
+		black = "Engine"
+	}
+
+	result := pdn.Result(logic.GetWinner(&s.Game), r)
+	game := pdn.New(r, white, black, s.Moves, result)
+	game.SetTag("Event", "Terminal game")
+	if s.Start.Board != logic.StartingBoard(r) || s.Start.Turn != logic.NewGame(r).Turn {
+		game.SetTag("FEN", logic.FormatFEN(&s.Start))
+	}
+	return ioutil.WriteFile(path, []byte(game.String()+"\n"), 0644)
+}
+
+// Draws the board as seen by white with the square numbers on empty squares, the last move is highlighted
+func (s *session) print() {
+	r := s.Game.Rules()
+	board := s.Game.Board
+	if s.Game.Turn != logic.White(r) {
+		board = reverse(board)
+	}
+
+	// Squares the last move went from and to
+	moved := make(map[int]bool)
+	if len(s.Moves) > 0 {
+		for _, n := range strings.FieldsFunc(s.Moves[len(s.Moves)-1], func(c rune) bool { return c == '-' || c == 'x' }) {
+			number, _ := strconv.Atoi(n)
+			moved[number] = true
+		}
+	}
+
+	width := int(logic.Width(r))
+	var b strings.Builder
+	for i, c := range board {
+		row := i / width
+		number := i + 1
+
+		var cell string
+		switch c {
+		case '1':
+			cell = s.paint(BLUE_PIECE, s.piece("●", "b"))
+		case '2':
+			cell = s.paint(RED_PIECE, s.piece("●", "r"))
+		case '3':
+			cell = s.paint(BLUE_PIECE, s.piece("K", "B"))
+		case '4':
+			cell = s.paint(RED_PIECE, s.piece("K", "R"))
+		default:
+			cell = s.paint(SQUARE_TEXT, fmt.Sprintf("%3d", number))
+		}
+		background := DARK
+		if moved[number] {
+			background = HIGHLIGHT
+		}
+		cell = s.paint(background, cell)
+		light := s.paint(LIGHT, "   ")
+
+		// Rows start on a light square every other row, rotated boards the other way round
+		if (row%2 == 0) != r.Rotated() {
+			b.WriteString(light + cell)
+		} else {
+			b.WriteString(cell + light)
+		}
+		if (i+1)%width == 0 {
+			b.WriteString("\n")
+		}
+	}
+
+	status := colorName(s.Game.Turn) + " to move"
+	if winner := logic.GetWinner(&s.Game); winner != 0 {
+		status = colorName(winner) + " wins!"
+	}
+	if len(s.Moves) > 0 {
+		status += ", last move " + s.Moves[len(s.Moves)-1]
+	}
+	fmt.Print(b.String())
+	fmt.Println(status)
+}
+
+// Gets how a piece is drawn, without colors the letter tells the players apart
+func (s *session) piece(symbol string, letter string) string {
+	if !s.Color {
+		return " " + letter + " "
+	}
+	return " " + symbol + " "
+}
+
+// Wraps text in an ANSI code when colors are on
+func (s *session) paint(code string, text string) string {
+	if !s.Color {
+		if code == LIGHT {
+			return strings.Repeat(" ", len(text))
+		}
+		return text
+	}
+	return code + text + RESET
+}
+
+// Reverses a board so it is seen by the other player
+func reverse(board string) string {
+	b := []byte(board)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
@@ -0,0 +1,117 @@
+// Plays matches between the engine and another engine over the DamExchange protocol
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/dxp"
+	"github.com/jmsheff/discord-checkers/endgame"
+	"github.com/jmsheff/discord-checkers/engine"
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/pdn"
+)
+
+func main() {
+	listen := flag.String("listen", "", "address to wait for games on as the follower, for example :"+strconv.Itoa(dxp.PORT))
+	connect := flag.String("connect", "", "address of the engine to play as the initiator, for example localhost:"+strconv.Itoa(dxp.PORT))
+	name := flag.String("name", "discord-checkers", "name sent to the other engine")
+	variant := flag.String("variant", logic.International{}.Name(), "variant to play")
+	games := flag.Int("games", 2, "number of games to play as the initiator, colors alternate")
+	minutes := flag.Int("time", 5, "thinking time in minutes sent in game requests")
+	moves := flag.Int("moves", 75, "number of moves in the thinking time sent in game requests")
+	maxPlies := flag.Int("maxplies", 300, "number of plies after which a game is a draw")
+	depth := flag.Int("depth", engine.DEFAULT.Depth, "maximum depth the engine searches")
+	moveTime := flag.Duration("movetime", engine.DEFAULT.Time, "time the engine searches each move for")
+	tablebases := flag.String("tablebases", "", "directory with endgame tablebases")
+	out := flag.String("pdn", "dxp.pdn", "file the games are added to")
+	flag.Parse()
+
+	r, err := logic.GetRules(*variant)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	config := engine.Config{Depth: *depth, Time: *moveTime}
+	if *tablebases != "" {
+		databases, err := endgame.LoadDir(*tablebases)
+		if err != nil {
+			log.Fatal(err)
+		}
+		config.Tablebase = databases
+	}
+
+	settings := dxp.Settings{Name: *name, Rules: r, Time: *minutes, Moves: *moves, MaxPlies: *maxPlies}
+	player := dxp.EnginePlayer{Engine: engine.New(config)}
+
+	switch {
+	case *connect != "":
+		c, err := dxp.Dial(*connect)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer c.Close()
+
+		// Alternate colors so both engines play both sides
+		for i := 0; i < *games; i++ {
+			color := logic.White(r)
+			if i%2 == 1 {
+				color = 3 - color
+			}
+			game, err := dxp.Initiate(c, settings, color, logic.NewGame(r), player)
+			save(*out, game)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+	case *listen != "":
+		l, err := net.Listen("tcp", *listen)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Print("Waiting for games on ", l.Addr())
+
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				log.Fatal(err)
+			}
+			c := dxp.NewConn(conn)
+			for {
+				game, err := dxp.Follow(c, settings, player)
+				if err == io.EOF {
+					break
+				}
+				save(*out, game)
+				if err != nil {
+					log.Print(err)
+					break
+				}
+			}
+			c.Close()
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+// Adds a game to the PDN file and logs the result
+func save(path string, game pdn.Game) {
+	if len(game.Moves) == 0 {
+		return
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	f.WriteString(game.String() + "\n")
+	log.Printf("Game finished %s after %d plies at %s", game.Result, len(game.Moves), time.Now().Format(time.Kitchen))
+}
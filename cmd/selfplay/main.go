@@ -0,0 +1,250 @@
+// Plays games between two engine settings from the three move ballots to measure which one is stronger
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/endgame"
+	"github.com/jmsheff/discord-checkers/engine"
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/openings"
+	"github.com/jmsheff/discord-checkers/pdn"
+)
+
+// A game to play, every ballot is played twice with the colors swapped
+type job struct {
+	Ballot  int  // Number of the ballot starting from 1
+	Swapped bool // If the second settings play white
+}
+
+// The result of a game for the first settings
+type outcome struct {
+	Job    job
+	Score  int      // 1 for a win, 0 for a draw and -1 for a loss
+	Record pdn.Game // The game
+}
+
+// Keeps track of the results and the sequential probability ratio test
+type tally struct {
+	Wins, Draws, Losses int
+}
+
+// Gets the number of games played
+func (t tally) games() int {
+	return t.Wins + t.Draws + t.Losses
+}
+
+// Gets the score per game and its variance, half a game is added to every result so nothing is ever 0
+func (t tally) stats() (float64, float64) {
+	w, d, l := float64(t.Wins)+0.5, float64(t.Draws)+0.5, float64(t.Losses)+0.5
+	n := w + d + l
+	mean := (w + d/2) / n
+	variance := (w/n + d/n/4 - mean*mean) / n
+	return mean, variance
+}
+
+// Converts a score per game to an elo difference
+func elo(score float64) float64 {
+	return -400 * math.Log10(1/score-1)
+}
+
+// Converts an elo difference to the expected score per game
+func expected(elo float64) float64 {
+	return 1 / (1 + math.Pow(10, -elo/400))
+}
+
+// Gets the elo difference and its 95% confidence interval
+func (t tally) elo() (float64, float64, float64) {
+	mean, variance := t.stats()
+	margin := 1.96 * math.Sqrt(variance)
+	return elo(mean), elo(math.Max(mean-margin, 1e-6)), elo(math.Min(mean+margin, 1-1e-6))
+}
+
+// Gets the log likelihood ratio of the first settings being elo1 stronger rather than elo0
+func (t tally) llr(elo0 float64, elo1 float64) float64 {
+	mean, variance := t.stats()
+	s0, s1 := expected(elo0), expected(elo1)
+	return (s1 - s0) * (2*mean - s0 - s1) / (2 * variance)
+}
+
+func main() {
+	depth1 := flag.Int("depth1", engine.DEFAULT.Depth, "maximum search depth of the first engine")
+	time1 := flag.Duration("movetime1", 100*time.Millisecond, "time the first engine searches each move for")
+	depth2 := flag.Int("depth2", engine.DEFAULT.Depth, "maximum search depth of the second engine")
+	time2 := flag.Duration("movetime2", 100*time.Millisecond, "time the second engine searches each move for")
+	tablebases := flag.String("tablebases", "", "directory with endgame tablebases both engines use")
+	games := flag.Int("games", 2*len(openings.Ballots), "most games to play, every ballot is played with both colors")
+	workers := flag.Int("workers", runtime.NumCPU(), "number of games played at the same time")
+	maxPlies := flag.Int("maxplies", 200, "number of plies after which a game is a draw")
+	elo0 := flag.Float64("elo0", 0, "elo difference of the null hypothesis")
+	elo1 := flag.Float64("elo1", 20, "elo difference of the alternative hypothesis")
+	alpha := flag.Float64("alpha", 0.05, "chance of accepting the alternative hypothesis when it is false")
+	beta := flag.Float64("beta", 0.05, "chance of accepting the null hypothesis when it is false")
+	out := flag.String("pdn", "", "file to write the games to")
+	flag.Parse()
+
+	var tb engine.Tablebase
+	if *tablebases != "" {
+		databases, err := endgame.LoadDir(*tablebases)
+		if err != nil {
+			log.Fatal(err)
+		}
+		tb = databases
+	}
+	configs := [2]engine.Config{
+		{Depth: *depth1, Time: *time1, Tablebase: tb},
+		{Depth: *depth2, Time: *time2, Tablebase: tb},
+	}
+
+	var pdnFile *os.File
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		pdnFile = f
+	}
+
+	lower, upper := math.Log(*beta/(1-*alpha)), math.Log((1-*beta)/(*alpha))
+	jobs := make(chan job)
+	results := make(chan outcome)
+	stop := make(chan struct{})
+
+	// Queue every ballot with both colors, going through the deck in a random order so a test that stops early still plays varied openings.
+	// The deck is shuffled again for every pass through it
+	go func() {
+		defer close(jobs)
+		var deck []int
+		for i := 0; i < *games; i++ {
+			if i%(2*len(openings.Ballots)) == 0 {
+				deck = rand.Perm(len(openings.Ballots))
+			}
+			j := job{Ballot: deck[i/2%len(deck)] + 1, Swapped: i%2 == 1}
+			select {
+			case jobs <- j:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < *workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Engines keep state between searches so every worker needs its own
+			engines := [2]*engine.Engine{engine.New(configs[0]), engine.New(configs[1])}
+			for j := range jobs {
+				o, err := play(j, engines, *maxPlies)
+				if err != nil {
+					log.Print(err)
+					continue
+				}
+				results <- o
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var t tally
+	decided := ""
+	for o := range results {
+		switch o.Score {
+		case 1:
+			t.Wins++
+		case 0:
+			t.Draws++
+		default:
+			t.Losses++
+		}
+		if pdnFile != nil {
+			fmt.Fprintln(pdnFile, o.Record.String())
+		}
+
+		llr := t.llr(*elo0, *elo1)
+		diff, low, high := t.elo()
+		log.Printf("Games: %d W: %d D: %d L: %d Elo: %.1f [%.1f, %.1f] LLR: %.2f [%.2f, %.2f]", t.games(), t.Wins, t.Draws, t.Losses, diff, low, high, llr, lower, upper)
+
+		if decided == "" && llr >= upper {
+			decided = fmt.Sprintf("H1 accepted: the first engine is at least %.0f elo stronger", *elo1)
+			close(stop)
+		} else if decided == "" && llr <= lower {
+			decided = fmt.Sprintf("H0 accepted: the first engine is not %.0f elo stronger", *elo1)
+			close(stop)
+		}
+	}
+
+	if decided == "" {
+		decided = "Inconclusive: play more games to decide the test"
+	}
+	fmt.Println(decided)
+}
+
+// Plays a ballot between the two engines
+func play(j job, engines [2]*engine.Engine, maxPlies int) (outcome, error) {
+	game, err := openings.BallotGame(j.Ballot)
+	if err != nil {
+		return outcome{}, err
+	}
+	r := game.Rules()
+
+	// The engine of each player, the first settings play white unless swapped
+	white := logic.White(r)
+	players := map[uint8]*engine.Engine{white: engines[0], 3 - white: engines[1]}
+	if j.Swapped {
+		players[white], players[3-white] = engines[1], engines[0]
+	}
+
+	ballot := openings.Ballots[j.Ballot-1]
+	notation := strings.Fields(ballot)
+
+	winner := uint8(0)
+	for len(notation) < maxPlies {
+		if winner = logic.GetWinner(&game); winner != 0 {
+			break
+		}
+
+		result, err := players[game.Turn].BestMove(game)
+		if err != nil {
+			return outcome{}, err
+		}
+		notation = append(notation, logic.FormatSequence(result.Move, &game))
+		logic.ApplySequence(result.Move, &game)
+		logic.SwapTurn(&game)
+	}
+
+	o := outcome{Job: j}
+	if winner != 0 {
+		o.Score = 1
+		if (winner == white) == j.Swapped {
+			o.Score = -1
+		}
+	}
+
+	names := [2]string{"Engine 1", "Engine 2"}
+	if j.Swapped {
+		names[0], names[1] = names[1], names[0]
+	}
+	result := pdn.DRAW
+	if winner != 0 {
+		result = pdn.Result(winner, r)
+	}
+	o.Record = pdn.New(r, names[0], names[1], notation, result)
+	o.Record.SetTag("Event", "Self-play")
+	o.Record.SetTag("Opening", "Ballot "+ballot)
+	return o, nil
+}
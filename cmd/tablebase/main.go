@@ -0,0 +1,42 @@
+// Generates endgame tablebases for the engine and the bot to use
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/endgame"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+func main() {
+	variant := flag.String("variant", logic.Variants[0].Name(), "variant to generate the tablebase for")
+	pieces := flag.Int("pieces", 4, "most pieces on the board in a position")
+	dir := flag.String("out", "tablebases", "directory to write the tablebase to")
+	flag.Parse()
+
+	r, err := logic.GetRules(*variant)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(*dir, 0755); err != nil {
+		log.Fatal(err)
+	}
+
+	start := time.Now()
+	tb, err := endgame.Generate(r, *pieces, func(n int, positions int) {
+		log.Printf("Solved %d positions with %d pieces (%s)", positions, n, time.Since(start).Round(time.Second))
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	path := filepath.Join(*dir, r.Name()+endgame.EXTENSION)
+	if err := tb.Save(path); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Saved %d positions to %s", tb.Positions(), path)
+}
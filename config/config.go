@@ -0,0 +1,225 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Reads the settings of the bot from a TOML file, then the environment, then the command line flags, each overriding the one before
+
+// Name of the environment variable with the path of the config file, the -config flag takes precedence
+const PATH_ENV = "CONFIG_PATH"
+
+// Name of the environment variable with the token of the bot, the token is never read from a flag so it doesn't show up in the process list
+const TOKEN_ENV = "BOT_TOKEN"
+
+// The settings of the bot
+type Config struct {
+	Token         string     // Token the bot connects to Discord with
+	TokenFile     string     // File the token is read from when it isn't in the environment
+	StoragePath   string     // File the data of the bot is saved in, empty to keep it in memory
+	TablebasePath string     // Directory of the endgame databases, empty to go without
+	BallotsPath   string     // File with the deck of three move ballots, empty for the bundled deck
+	HTTPAddr      string     // Address to serve the web board and API on, for example :8080
+	WebURL        string     // Public URL of the web board, linked from Discord
+	MetricsAddr   string     // Address to serve the metrics and health check on, for example :9090
+	LogLevel      slog.Level // Lowest level of the lines logged
+	PuzzleChannel string     // Channel the daily puzzle is posted in
+	PuzzleTime    ClockTime  // Time of day the daily puzzle is posted at
+	WebhookURLs   string     // Comma separated URLs the game events are sent to
+	WebhookSecret string     // Secret the webhook payloads are signed with
+	Engine        Engine     // Settings of the engine
+	Features      Features   // Parts of the bot that can be turned off
+}
+
+// The settings of the engine
+type Engine struct {
+	Depth int           // Maximum depth the bot searches in plies
+	Time  time.Duration // Maximum time the bot searches for a move, 0 for no limit
+}
+
+// Parts of the bot that can be turned off, a part also needs its own settings to run
+type Features struct {
+	Web          bool // Serve the web board and API
+	Metrics      bool // Serve the metrics and health check
+	Webhooks     bool // Send game events to webhooks
+	DailyPuzzles bool // Post a puzzle every day
+}
+
+// A time of day as the time since midnight, written as 15:04
+type ClockTime time.Duration
+
+// Formats the time of day as 15:04
+func (t *ClockTime) String() string {
+	return time.Time{}.Add(time.Duration(*t)).Format("15:04")
+}
+
+// Parses a time of day written as 15:04
+func (t *ClockTime) Set(s string) error {
+	at, err := time.Parse("15:04", s)
+	if err != nil {
+		return errors.New("Expected a time of day like 15:04")
+	}
+	*t = ClockTime(time.Duration(at.Hour())*time.Hour + time.Duration(at.Minute())*time.Minute)
+	return nil
+}
+
+// Loads the settings from the config file, the environment and the given command line arguments, every invalid setting is returned as one error
+func Load(args []string) (*Config, error) {
+	c := &Config{PuzzleTime: ClockTime(12 * time.Hour)}
+	fs := flags(c)
+	var errs []error
+
+	// The file is read first, so the path has to be found before the other flags are parsed
+	path := os.Getenv(PATH_ENV)
+	if p, ok := flagValue(args, "config"); ok {
+		path = p
+	}
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, errors.New("Could not open the config file: " + err.Error())
+		}
+		values, err := parseTOML(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("Could not read the config file %s: %s", path, err)
+		}
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys) // The errors come in the same order on every start
+		for _, key := range keys {
+			if key == "config" || fs.Lookup(key) == nil {
+				errs = append(errs, fmt.Errorf("Unknown setting %s in %s", key, path))
+			} else if err := fs.Set(key, values[key]); err != nil {
+				errs = append(errs, fmt.Errorf("Invalid %s in %s: %s", key, path, err))
+			}
+		}
+	}
+
+	// Every setting can be given in the environment, named like the key in capitals with underscores
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" {
+			return
+		}
+		name := envName(f.Name)
+		if value, ok := os.LookupEnv(name); ok && value != "" {
+			if err := fs.Set(f.Name, value); err != nil {
+				errs = append(errs, fmt.Errorf("Invalid %s: %s", name, err))
+			}
+		}
+	})
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != 0 {
+		errs = append(errs, errors.New("Unexpected arguments: "+strings.Join(fs.Args(), " ")))
+	}
+
+	// Settings that couldn't be parsed are left at zero, so they are only checked once every setting was parsed
+	if len(errs) == 0 {
+		errs = append(errs, c.validate()...)
+	}
+	errs = append(errs, c.readToken()...)
+	if len(errs) != 0 {
+		return nil, errors.Join(errs...)
+	}
+	return c, nil
+}
+
+// Registers a flag for every setting, the name of a flag is the key of the setting in the config file
+func flags(c *Config) *flag.FlagSet {
+	fs := flag.NewFlagSet("discord-checkers", flag.ContinueOnError)
+	fs.String("config", "", "TOML file to read the settings from, also "+PATH_ENV)
+	fs.StringVar(&c.TokenFile, "token_file", "", "File to read the bot token from when "+TOKEN_ENV+" is not set")
+	fs.StringVar(&c.StoragePath, "storage_path", "", "File to save the data of the bot in, empty to keep it in memory")
+	fs.StringVar(&c.TablebasePath, "tablebase_path", "", "Directory of endgame databases to adjudicate games with")
+	fs.StringVar(&c.BallotsPath, "ballots_path", "", "File with one three move ballot per line to draw from instead of the bundled deck, like the ACF deck")
+	fs.StringVar(&c.HTTPAddr, "http_addr", "", "Address to serve the web board and API on, for example :8080")
+	fs.StringVar(&c.WebURL, "web_url", "", "Public URL of the web board, linked from Discord")
+	fs.StringVar(&c.MetricsAddr, "metrics_addr", "", "Address to serve the metrics and health check on, for example :9090")
+	fs.TextVar(&c.LogLevel, "log_level", slog.LevelInfo, "Lowest level to log: debug, info, warn or error")
+	fs.StringVar(&c.PuzzleChannel, "puzzle_channel", "", "Channel ID to post a puzzle in every day")
+	fs.Var(&c.PuzzleTime, "puzzle_time", "Time of day to post the daily puzzle at, in UTC")
+	fs.StringVar(&c.WebhookURLs, "webhook_urls", "", "Comma separated URLs to send game events to")
+	fs.StringVar(&c.WebhookSecret, "webhook_secret", "", "Secret to sign the webhook payloads with")
+	fs.IntVar(&c.Engine.Depth, "engine.depth", 8, "Maximum depth the bot searches in plies")
+	fs.DurationVar(&c.Engine.Time, "engine.time", 2*time.Second, "Maximum time the bot searches for a move, 0 for no limit")
+	fs.BoolVar(&c.Features.Web, "features.web", true, "Serve the web board and API when http_addr is set")
+	fs.BoolVar(&c.Features.Metrics, "features.metrics", true, "Serve the metrics when metrics_addr is set")
+	fs.BoolVar(&c.Features.Webhooks, "features.webhooks", true, "Send game events when webhook_urls is set")
+	fs.BoolVar(&c.Features.DailyPuzzles, "features.daily_puzzles", true, "Post a daily puzzle when puzzle_channel is set")
+	return fs
+}
+
+// Gets the environment variable of a setting, engine.depth is ENGINE_DEPTH
+func envName(key string) string {
+	return strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// Finds the value of a string flag in command line arguments before they are parsed
+func flagValue(args []string, name string) (string, bool) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+		arg = strings.TrimPrefix(strings.TrimPrefix(arg, "-"), "-")
+		if arg == name && i+1 < len(args) {
+			return args[i+1], true
+		}
+		if strings.HasPrefix(arg, name+"=") {
+			return strings.TrimPrefix(arg, name+"="), true
+		}
+	}
+	return "", false
+}
+
+// Reads the token from the environment, or from the token file when it isn't set
+func (c *Config) readToken() []error {
+	c.Token = strings.TrimSpace(os.Getenv(TOKEN_ENV))
+	if c.Token != "" {
+		return nil
+	}
+	if c.TokenFile == "" {
+		return []error{errors.New("Missing token, set " + TOKEN_ENV + " or token_file")}
+	}
+	token, err := os.ReadFile(c.TokenFile)
+	if err != nil {
+		return []error{errors.New("Could not read the token file: " + err.Error())}
+	}
+	if c.Token = strings.TrimSpace(string(token)); c.Token == "" {
+		return []error{errors.New("The token file " + c.TokenFile + " is empty")}
+	}
+	return nil
+}
+
+// Checks the settings that can be parsed but still make no sense
+func (c *Config) validate() []error {
+	var errs []error
+	if c.Engine.Depth <= 0 {
+		errs = append(errs, errors.New("engine.depth has to be at least 1"))
+	}
+	if c.Engine.Time < 0 {
+		errs = append(errs, errors.New("engine.time can't be negative"))
+	}
+	for _, u := range strings.Split(c.WebhookURLs, ",") {
+		if u = strings.TrimSpace(u); u == "" {
+			continue
+		}
+		if parsed, err := url.Parse(u); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			errs = append(errs, errors.New("webhook_urls has an invalid URL "+u))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,207 @@
+package config
+
+import (
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// A token that is only used by the tests
+const TEST_TOKEN = "test-token"
+
+// Writes a config file for a test and returns its path
+func writeConfig(t *testing.T, toml string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte(toml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// Clears the environment variables of every setting for a test, so the environment of the machine running it doesn't matter
+func clearEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv(PATH_ENV, "")
+	t.Setenv(TOKEN_ENV, TEST_TOKEN)
+	flags(&Config{}).VisitAll(func(f *flag.Flag) {
+		t.Setenv(envName(f.Name), "")
+	})
+}
+
+// Settings nobody set keep their defaults
+func TestLoadDefaults(t *testing.T) {
+	clearEnv(t)
+	c, err := Load(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Token != TEST_TOKEN || c.Engine.Depth != 8 || c.Engine.Time != 2*time.Second || c.LogLevel != slog.LevelInfo ||
+		time.Duration(c.PuzzleTime) != 12*time.Hour || !c.Features.Web || c.HTTPAddr != "" {
+		t.Errorf("Defaults are %+v", c)
+	}
+}
+
+// The environment overrides the file and the flags override both
+func TestLoadPrecedence(t *testing.T) {
+	clearEnv(t)
+	path := writeConfig(t, `
+http_addr = ":1"
+web_url = "https://file.example"
+log_level = "debug"
+puzzle_time = "08:30"
+
+[engine]
+depth = 3
+time = "1s"
+
+[features]
+webhooks = false
+`)
+	t.Setenv(PATH_ENV, path)
+	t.Setenv("WEB_URL", "https://env.example")
+	t.Setenv("ENGINE_DEPTH", "4")
+	t.Setenv("ENGINE_TIME", "3s")
+
+	c, err := Load([]string{"-engine.depth", "5", "--http_addr=:2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		setting string
+		got     any
+		want    any
+	}{
+		{"http_addr from the flag over the file", c.HTTPAddr, ":2"},
+		{"web_url from the environment over the file", c.WebURL, "https://env.example"},
+		{"engine.depth from the flag over the environment and the file", c.Engine.Depth, 5},
+		{"engine.time from the environment over the file", c.Engine.Time, 3 * time.Second},
+		{"log_level from the file", c.LogLevel, slog.LevelDebug},
+		{"puzzle_time from the file", time.Duration(c.PuzzleTime), 8*time.Hour + 30*time.Minute},
+		{"features.webhooks from the file", c.Features.Webhooks, false},
+		{"features.web left at its default", c.Features.Web, true},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("%s is %v, want %v", test.setting, test.got, test.want)
+		}
+	}
+}
+
+// The -config flag is used over the config path in the environment
+func TestLoadConfigFlag(t *testing.T) {
+	clearEnv(t)
+	t.Setenv(PATH_ENV, writeConfig(t, `http_addr = ":1"`))
+	path := writeConfig(t, `http_addr = ":2"`)
+
+	for _, args := range [][]string{{"-config", path}, {"--config=" + path}} {
+		c, err := Load(args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.HTTPAddr != ":2" {
+			t.Errorf("%v read http_addr %s, want :2", args, c.HTTPAddr)
+		}
+	}
+}
+
+// The token is read from the token file when it isn't in the environment
+func TestLoadTokenFile(t *testing.T) {
+	clearEnv(t)
+	t.Setenv(TOKEN_ENV, "")
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte(TEST_TOKEN+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := Load([]string{"-token_file", path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Token != TEST_TOKEN {
+		t.Errorf("Read the token %q from the file", c.Token)
+	}
+}
+
+// Every invalid setting is returned in one error
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		toml string
+		env  map[string]string
+		args []string
+		want []string
+	}{
+		{
+			name: "unknown and invalid settings in the file",
+			toml: "depth = 3\n[engine]\ndepth = \"deep\"",
+			want: []string{"Unknown setting depth in", "Invalid engine.depth in"},
+		},
+		{
+			name: "config can't be set in the file",
+			toml: `config = "other.toml"`,
+			want: []string{"Unknown setting config in"},
+		},
+		{
+			name: "invalid environment",
+			env:  map[string]string{"PUZZLE_TIME": "noon", "FEATURES_WEB": "maybe"},
+			want: []string{"Invalid PUZZLE_TIME: Expected a time of day like 15:04", "Invalid FEATURES_WEB"},
+		},
+		{
+			name: "settings that make no sense",
+			args: []string{"-engine.depth", "0", "-engine.time", "-1s", "-webhook_urls", "https://ok.example, ftp://files.example"},
+			want: []string{"engine.depth has to be at least 1", "engine.time can't be negative", "webhook_urls has an invalid URL ftp://files.example"},
+		},
+		{
+			name: "extra arguments",
+			args: []string{"start"},
+			want: []string{"Unexpected arguments: start"},
+		},
+		{
+			name: "missing token",
+			env:  map[string]string{TOKEN_ENV: ""},
+			want: []string{"Missing token, set " + TOKEN_ENV + " or token_file"},
+		},
+		{
+			name: "unreadable file",
+			toml: "[engine",
+			want: []string{"Could not read the config file", "Line 1: Unclosed table name"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clearEnv(t)
+			if test.toml != "" {
+				t.Setenv(PATH_ENV, writeConfig(t, test.toml))
+			}
+			for name, value := range test.env {
+				t.Setenv(name, value)
+			}
+
+			_, err := Load(test.args)
+			if err == nil {
+				t.Fatalf("No error, want %q", test.want)
+			}
+			for _, want := range test.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("%q doesn't say %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+// A config file that doesn't exist stops the bot from starting
+func TestLoadMissingFile(t *testing.T) {
+	clearEnv(t)
+	if _, err := Load([]string{"-config", filepath.Join(t.TempDir(), "missing.toml")}); err == nil || !strings.HasPrefix(err.Error(), "Could not open the config file") {
+		t.Errorf("Loading a missing file returned %v", err)
+	}
+}
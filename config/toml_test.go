@@ -0,0 +1,75 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Settings are read as the text their flags take
+func TestParseTOML(t *testing.T) {
+	tests := []struct {
+		name string
+		toml string
+		want map[string]string
+	}{
+		{"empty file", "", map[string]string{}},
+		{"comments and blank lines", "# Settings\n\n   # indented\n", map[string]string{}},
+		{"basic string", `web_url = "https://example.com"`, map[string]string{"web_url": "https://example.com"}},
+		{"escapes", `a = "tab\there \"quoted\" \u00e9"`, map[string]string{"a": "tab\there \"quoted\" é"}},
+		{"literal string", `a = 'C:\path\to "file"'`, map[string]string{"a": `C:\path\to "file"`}},
+		{"empty string", `a = ""`, map[string]string{"a": ""}},
+		{"comment after a value", `a = "x" # the x`, map[string]string{"a": "x"}},
+		{"# in a basic string", `a = "#general" # channel`, map[string]string{"a": "#general"}},
+		{"# in a literal string", `a = '#general'`, map[string]string{"a": "#general"}},
+		{"escaped quote before #", `a = "say \"#1\"" # comment`, map[string]string{"a": `say "#1"`}},
+		{"numbers and booleans", "depth = 8\ntime = 2s\nweb = false", map[string]string{"depth": "8", "time": "2s", "web": "false"}},
+		{"spaces around keys", "  a=1  \n\tb   =   2", map[string]string{"a": "1", "b": "2"}},
+		{"tables", "a = 1\n[engine]\ndepth = 3\n[ features ]\nweb = true", map[string]string{"a": "1", "engine.depth": "3", "features.web": "true"}},
+		{"array", `urls = ["https://a.example", 'https://b.example']`, map[string]string{"urls": "https://a.example,https://b.example"}},
+		{"array with a trailing comma", `urls = [ "a", "b", ]`, map[string]string{"urls": "a,b"}},
+		{"empty array", `urls = []`, map[string]string{"urls": ""}},
+	}
+
+	for _, test := range tests {
+		got, err := parseTOML(strings.NewReader(test.toml))
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: read %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// Files that can't be read return an error with the line of the mistake
+func TestParseTOMLErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		toml string
+		want string
+	}{
+		{"unclosed table", "[engine", "Line 1: Unclosed table name"},
+		{"empty table", "a = 1\n[ ]", "Line 2: Empty table name"},
+		{"no value", "\nweb_url", "Line 2: Expected key = value"},
+		{"no key", `= "x"`, "Line 1: Missing key"},
+		{"key set twice", "a = 1\n# again\na = 2", "Line 3: a is set twice"},
+		{"key set twice in a table", "[engine]\ndepth = 1\ndepth = 2", "Line 3: engine.depth is set twice"},
+		{"missing value", "a = # nothing", "Line 1: Missing value"},
+		{"unclosed string", `a = "x`, `Line 1: Invalid string "x`},
+		{"unclosed literal string", `a = 'x`, "Line 1: Invalid string 'x"},
+		{"lone quote", `a = '`, "Line 1: Invalid string '"},
+		{"multi-line array", "a = [\n\"x\"]", "Line 1: Arrays have to be on one line"},
+		{"invalid array item", `a = ["x", "y]`, `Line 1: Invalid string "y`},
+	}
+
+	for _, test := range tests {
+		_, err := parseTOML(strings.NewReader(test.toml))
+		if err == nil {
+			t.Errorf("%s: no error, want %s", test.name, test.want)
+		} else if err.Error() != test.want {
+			t.Errorf("%s: %s, want %s", test.name, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package discord
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Handlers/Functions for the accessible mode, where boards are described in words for screen readers
+
+// Checks if a user reads boards in the accessible mode
+func isAccessible(userID string) bool {
+	return getUserPrefs(userID).Accessible
+}
+
+// Lists the numbers of the squares holding a piece, none if there are no such pieces
+func formatPieces(lang string, game *logic.Game, piece byte) string {
+	var numbers []int
+	for i := range game.Board {
+		if game.Board[i] == piece {
+			numbers = append(numbers, logic.SquareNumber(uint8(i), game))
+		}
+	}
+	if len(numbers) == 0 {
+		return i18n.T(lang, "accessible.none")
+	}
+
+	// Squares are read from the lowest number whichever side the board is seen from
+	sort.Ints(numbers)
+	var squares []string
+	for _, n := range numbers {
+		squares = append(squares, strconv.Itoa(n))
+	}
+	return strings.Join(squares, ", ")
+}
+
+// Describes where every piece stands by the standard numbers of the squares
+func describePosition(lang string, game *logic.Game) string {
+	return i18n.T(lang, "accessible.red", formatPieces(lang, game, '0'+c_PLAYER_RED), formatPieces(lang, game, '0'+c_PLAYER_RED+2)) + "\n" +
+		i18n.T(lang, "accessible.blue", formatPieces(lang, game, '0'+c_PLAYER_BLUE), formatPieces(lang, game, '0'+c_PLAYER_BLUE+2))
+}
+
+// Describes a move written in standard notation in words, with the number of pieces it captured
+func describeMove(lang string, notation string) string {
+	squares := strings.FieldsFunc(notation, func(r rune) bool { return r == '-' || r == 'x' })
+	if len(squares) < 2 {
+		return notation
+	}
+	from, to := squares[0], squares[len(squares)-1]
+	if captures := strings.Count(notation, "x"); captures > 0 {
+		return i18n.N(lang, "accessible.capture", captures, from, to)
+	}
+	return i18n.T(lang, "accessible.move", from, to)
+}
+
+// Describes the last move of a game, empty before the first move
+func describeLastMove(lang string, moves []string) string {
+	if len(moves) == 0 {
+		return ""
+	}
+	return i18n.T(lang, "accessible.last", describeMove(lang, moves[len(moves)-1]))
+}
+
+// Numbers every move the player to move can make, in the order the play command takes them
+func describeMoves(lang string, game *logic.Game) []string {
+	var options []string
+	for i, seq := range logic.GetSequences(game) {
+		options = append(options, strconv.Itoa(i+1)+". "+describeMove(lang, logic.FormatSequence(seq, game)))
+	}
+	return options
+}
+
+// Splits lines into embed fields with the same name that each fit in a field
+func linesFields(name string, lines []string) []*discordgo.MessageEmbedField {
+	var fields []*discordgo.MessageEmbedField
+	value := ""
+	for _, line := range lines {
+		if value != "" && len(value)+1+len(line) > c_FIELD_LIMIT {
+			fields = append(fields, &discordgo.MessageEmbedField{Name: name, Value: value})
+			value = ""
+		}
+		if value != "" {
+			value += "\n"
+		}
+		value += line
+	}
+	if value != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{Name: name, Value: value})
+	}
+	return fields
+}
+
+// Turns the accessible mode of a user on or off
+func accessibleCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+	l := newLog("command:accessible", "", m.GuildID, m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
+	prefix := getGuildConfig(m.GuildID).prefix()
+	if len(args) < 2 || (args[1] != "on" && args[1] != "off") {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "accessible.error.title"), i18n.T(lang, "accessible.error", prefix))))
+		return
+	}
+
+	prefs := getUserPrefs(m.Author.ID)
+	prefs.Accessible = args[1] == "on"
+	if err := store.Put("users", m.Author.ID, prefs); err != nil {
+		l.Error("Could not save the accessible mode", "err", err)
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "accessible.error.save"))))
+		return
+	}
+
+	if prefs.Accessible {
+		l.message(s.ChannelMessageSend(m.ChannelID, successMessage(i18n.T(lang, "accessible.on.title"), i18n.T(lang, "accessible.on", prefix))))
+		return
+	}
+	l.message(s.ChannelMessageSend(m.ChannelID, successMessage(i18n.T(lang, "accessible.off.title"), i18n.T(lang, "accessible.off"))))
+}
+
+// Plays one of the numbered moves of the latest board in a channel
+func playCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+	l := newLog("command:play", "", m.GuildID, m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
+	title := i18n.T(lang, "play.error.title")
+	found, err := latestGame(s, m.ChannelID)
+	if err != nil || found.Command != "select" {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(title, i18n.T(lang, "play.error.turn"))))
+		return
+	}
+
+	game, details := found.Game, found.Details
+	sequences := logic.GetSequences(&game)
+	number := 0
+	if len(args) > 1 {
+		number, _ = strconv.Atoi(strings.TrimSuffix(args[1], "."))
+	}
+	if number < 1 || number > len(sequences) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(title, i18n.T(lang, "play.error.number", len(sequences)))))
+		return
+	}
+	if isStale(&game, details) {
+		sendStale(s, l, lang, m.ChannelID, found.Message.ID)
+		return
+	}
+	seq := sequences[number-1]
+	notation := logic.FormatSequence(seq, &game)
+
+	// Puzzles are checked against their solution, like moves made with reactions
+	if details.Puzzle != 0 {
+		details.Moves = append(append([]string{}, details.Moves...), notation)
+		logic.ApplySequence(seq, &game)
+		r := &discordgo.MessageReactionAdd{MessageReaction: &discordgo.MessageReaction{UserID: m.Author.ID, ChannelID: m.ChannelID, MessageID: found.Message.ID}}
+		puzzleMoveHandler(s, l, r, m.Author, game, details)
+		return
+	}
+
+	if details.Game == "" {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(title, i18n.T(lang, "play.error.game"))))
+		return
+	}
+
+	// The game service sends the boards and messages that follow the move, like for moves made with reactions
+	origin := reactionOrigin{ChannelID: m.ChannelID, MessageID: found.Message.ID, OpponentID: found.OpponentID, Details: details}
+	_, err = gameService.Move(details.Game, game.Turn, notation, games.SOURCE_DISCORD, origin)
+	if err == games.ErrOver {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "game.over.title"), i18n.T(lang, "game.over"))))
+	} else if err == games.ErrStale {
+		sendStale(s, l, lang, m.ChannelID, found.Message.ID)
+	} else if err != nil {
+		l.Error("Could not make the move", "err", err)
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), formatError(lang, err))))
+	}
+}
@@ -0,0 +1,110 @@
+package discord
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/endgame"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Endgame databases used to adjudicate games
+var tablebases endgame.Databases
+
+// Sets the endgame databases used to adjudicate games
+func UseTablebases(d endgame.Databases) {
+	tablebases = d
+}
+
+// Errors returned when there is no game to find in a channel
+var (
+	errNoMessages = errors.New("Could not get the messages in this channel.")
+	errNoGame     = errors.New("There is no game in this channel.")
+)
+
+// A game found in a channel
+type foundGame struct {
+	Message    *discordgo.Message // The message the game is in
+	Command    string             // The command in the footer of the message
+	OpponentID string             // The opponent of the player the channel belongs to
+	Game       logic.Game         // The game, the turn is set to the player who has to move next
+	Details    gameDetails        // The details of the game
+}
+
+// Finds the latest game in a channel
+func latestGame(s *discordgo.Session, channelID string) (foundGame, error) {
+	messages, err := s.ChannelMessages(channelID, 100, "", "", "")
+	if err != nil {
+		return foundGame{}, errNoMessages
+	}
+
+	for _, m := range messages {
+		if m.Author.ID != s.State.User.ID || len(m.Embeds) != 1 || m.Embeds[0].Footer == nil {
+			continue
+		}
+
+		args := strings.Split(m.Embeds[0].Footer.Text, ":")
+		if len(args) != 2 || (args[0] != "select" && args[0] != "move" && args[0] != "spectate") {
+			continue
+		}
+
+		opponentID, game, err := ParseGame(args[1])
+		if err != nil {
+			return foundGame{}, err
+		}
+		// Spectated games are saved right after the move, before the turn is swapped
+		if args[0] == "spectate" {
+			if err := logic.SwapTurn(&game); err != nil {
+				return foundGame{}, err
+			}
+		}
+
+		return foundGame{Message: m, Command: args[0], OpponentID: opponentID, Game: game, Details: parseDetails(args[1])}, nil
+	}
+
+	return foundGame{}, errNoGame
+}
+
+// Tells the players the result of the game with perfect play
+func adjudicateCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate) {
+	l := newLog("command:adjudicate", "", m.GuildID, m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
+	found, err := latestGame(s, m.ChannelID)
+	if err != nil {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "adjudicate.error.title"), formatError(lang, err))))
+		return
+	}
+	game := found.Game
+
+	if logic.IsMultiJump(&game) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "adjudicate.error.title"), i18n.T(lang, "adjudicate.error.capture"))))
+		return
+	}
+
+	value, ok := tablebases.Probe(&game)
+	if !ok {
+		r := game.Rules()
+		pieces := len(game.Board) - strings.Count(game.Board, "0")
+		if tablebases.Pieces(r) == 0 {
+			l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "adjudicate.error.title"), i18n.T(lang, "adjudicate.error.variant", variantTitle(lang, r)))))
+			return
+		}
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "adjudicate.error.title"), i18n.N(lang, "adjudicate.error.pieces", pieces, tablebases.Pieces(r)))))
+		return
+	}
+
+	l.message(s.ChannelMessageSend(m.ChannelID, successMessage(i18n.T(lang, "adjudicate.title"), formatValue(lang, value, game.Turn))))
+}
+
+// Describes the value of a position for the player whose turn it is
+func formatValue(lang string, value endgame.Value, turn uint8) string {
+	switch value.Outcome {
+	case endgame.WIN:
+		return i18n.N(lang, "adjudicate.win", value.Plies, formatColor(lang, turn))
+	case endgame.LOSS:
+		return i18n.N(lang, "adjudicate.win", value.Plies, formatColor(lang, otherColor(turn)))
+	}
+	return i18n.T(lang, "adjudicate.draw")
+}
@@ -0,0 +1,71 @@
+package discord
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Handlers/Functions for games against the bot
+
+// Starts a game between the sender and the bot in the DM of the sender
+func startAIGame(s *discordgo.Session, m *discordgo.MessageCreate, options inviteOptions) {
+	l := newLog("command:invite", "", m.GuildID, m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
+	game, details, err := newInviteGame(options)
+	if err != nil {
+		l.Error("Could not play the opening ballot", "err", err)
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "invite.error.ballot.bot"))))
+		return
+	}
+
+	// The bot makes its moves through the game service, so the game has to be saved
+	bot := s.State.User
+	details.Game = saveGame(&game, map[uint8]*discordgo.User{options.Color: m.Author, otherColor(options.Color): bot}, details, options.Time)
+	g, ok := gameService.Store.Get(details.Game)
+	if !ok {
+		l.Error("Could not save the game against the bot")
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "ai.error.start"))))
+		return
+	}
+
+	dm, err := s.UserChannelCreate(m.Author.ID)
+	if !l.check(err) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "error.dm"))))
+		return
+	}
+	startSpectating(s, options.Guild, details.Game)
+	startReporting(options.Guild, details.Game)
+	l.message(s.ChannelMessageSend(m.ChannelID, successMessage(i18n.T(lang, "game.start.title"), i18n.T(lang, "ai.start", formatColor(lang, options.Color)))))
+
+	// If it's the bots turn it makes the first move and the board is sent once it has
+	if game.Turn != options.Color {
+		l.message(s.ChannelMessageSend(dm.ID, successMessage(i18n.T(lang, "game.start.title"), i18n.T(lang, "ai.start.wait", formatColor(lang, options.Color)))))
+		go playEngineMove(g)
+		return
+	}
+
+	gamemsg, err := s.ChannelMessageSendEmbed(dm.ID, gameEmbed(s, dm.GuildID, "select", m.Author.ID, bot.ID, &game, details, nil, false))
+	if !l.check(err) {
+		return
+	}
+	addSelectReactions(s, dm.ID, gamemsg.ID, &game)
+}
+
+// Makes the move the engine finds best for the player to move, the board is sent to the other player by the game service
+func playEngineMove(g games.Game) {
+	l := eventLog("engine_move", g)
+	position := g.Position()
+	result, err := newEngine().BestMove(position)
+	if err != nil {
+		l.Error("Could not find a move", "err", err)
+		return
+	}
+	if len(result.Move.Steps) == 0 {
+		return
+	}
+	if _, err := gameService.Move(g.ID, g.Turn, logic.FormatSequence(result.Move, &position), games.SOURCE_DISCORD, nil); err != nil {
+		l.Error("Could not make the move of the engine", "err", err)
+	}
+}
@@ -0,0 +1,213 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/engine"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/openings"
+)
+
+// Handlers/Functions for everything related to the engine helping players
+
+// The number of lines shown when analyzing a position
+const c_ANALYSIS_LINES = 3
+
+// Creates an engine with the settings used by the bot
+func newEngine() *engine.Engine {
+	return engine.New(engine.Config{
+		Depth:     engine.DEFAULT.Depth,
+		Time:      engine.DEFAULT.Time,
+		Book:      openings.Book{},
+		Tablebase: tablebases,
+	})
+}
+
+// Formats an engine score in pieces, or in plies when a player can force a win
+func formatScore(lang string, score int) string {
+	if score > engine.WIN-1000 {
+		return i18n.N(lang, "analysis.score.win", engine.WIN-score)
+	} else if score < -(engine.WIN - 1000) {
+		return i18n.N(lang, "analysis.score.loss", engine.WIN+score)
+	}
+	return fmt.Sprintf("%+.2f", float64(score)/engine.MAN)
+}
+
+// Formats a line of moves in standard notation, returns the position at the end of it
+func formatLine(pv []logic.Sequence, game logic.Game) (string, logic.Game) {
+	var moves []string
+	for _, seq := range pv {
+		if len(seq.Steps) == 0 {
+			break
+		}
+		moves = append(moves, logic.FormatSequence(seq, &game))
+		logic.ApplySequence(seq, &game)
+		logic.SwapTurn(&game)
+	}
+
+	return strings.Join(moves, " "), game
+}
+
+// Gets the board of a game as seen by a player
+func boardFor(game *logic.Game, player uint8) string {
+	if game.Turn == player {
+		return game.Board
+	}
+
+	board := []byte(game.Board)
+	for i, j := 0, len(board)-1; i < j; i, j = i+1, j-1 {
+		board[i], board[j] = board[j], board[i]
+	}
+	return string(board)
+}
+
+// Handles all hint commands
+func hintCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate, cmd []string) {
+	l := newLog("command:hint", "", m.GuildID, m.Author.ID)
+	prefix := getGuildConfig(m.GuildID).prefix()
+	lang := languageOf(m.Author.ID, m.GuildID)
+	found, err := latestGame(s, m.ChannelID)
+	if err != nil {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "hint.error.game"), formatError(lang, err))))
+		return
+	}
+
+	if !found.Details.Casual {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "hint.error.casual.title"), i18n.T(lang, "hint.error.casual"))))
+		return
+	}
+	if found.Command == "spectate" {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "hint.error.turn.title"), i18n.T(lang, "hint.error.turn"))))
+		return
+	}
+
+	// Turn hints on or off for the rest of the game
+	if len(cmd) > 1 {
+		switch strings.ToLower(cmd[1]) {
+		case "on":
+			found.Details.Hints = true
+		case "off":
+			found.Details.Hints = false
+		default:
+			l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "hint.error.option.title"), i18n.T(lang, "hint.error.option", prefix))))
+			return
+		}
+
+		embed := found.Message.Embeds[0]
+		embed.Footer.Text = found.Command + ":" + StringifyGame(found.OpponentID, &found.Game) + " " + stringifyDetails(found.Details, &found.Game)
+		if _, err := s.ChannelMessageEditEmbed(m.ChannelID, found.Message.ID, embed); !l.check(err) {
+			l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "hint.error.update"))))
+			return
+		}
+		title := i18n.T(lang, "hint.on.title")
+		if !found.Details.Hints {
+			title = i18n.T(lang, "hint.off.title")
+		}
+		l.message(s.ChannelMessageSend(m.ChannelID, successMessage(title, i18n.T(lang, "hint.changed"))))
+		return
+	}
+
+	if !found.Details.Hints {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "hint.off.title"), i18n.T(lang, "hint.off", prefix))))
+		return
+	}
+
+	game := found.Game
+	result, err := newEngine().BestMove(game)
+	if err != nil {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "hint.error.title"), formatError(lang, err))))
+		return
+	}
+
+	// Show where the piece ends up and how to select it
+	from := result.Move.From
+	description := i18n.T(lang, "hint.select", ySlice[from.Y], xSlice[from.X])
+	if logic.IsMultiJump(&game) {
+		description = i18n.T(lang, "hint.jump")
+	}
+	move := logic.FormatSequence(result.Move, &game)
+	if !result.Book {
+		move += " (" + formatScore(lang, result.Score) + ")"
+	}
+	description += "\n" + i18n.T(lang, "hint.move", move)
+
+	l.message(s.ChannelMessageSendEmbed(m.ChannelID, &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "hint.title"),
+		Description: description,
+		Color:       c_GOLD,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:  i18n.T(lang, "game.board"),
+				Value: formatBoard(&game.Board, game.Rules(), map[uint8]string{result.Move.To().Index: "🎯"}, userTheme(m.Author.ID)),
+			},
+		},
+	}))
+}
+
+// Handles all analyze commands
+func analyzeCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate, cmd []string) {
+	l := newLog("command:analyze", "", m.GuildID, m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
+	r := logic.Variants[0]
+	var fen []string
+	for _, arg := range cmd[1:] {
+		if strings.HasPrefix(strings.ToLower(arg), "variant:") {
+			rules, err := logic.GetRules(arg[len("variant:"):])
+			if err != nil {
+				l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "analysis.error.variant.title"), i18n.T(lang, "analysis.error.variant", variantNames()))))
+				return
+			}
+			r = rules
+			continue
+		}
+		fen = append(fen, arg)
+	}
+
+	if len(fen) == 0 {
+		prefix := getGuildConfig(m.GuildID).prefix()
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "analysis.error.position.title"), i18n.T(lang, "analysis.error.position", prefix))))
+		return
+	}
+	game, err := logic.ParseFEN(strings.Join(fen, " "), r)
+	if err != nil {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "analysis.error.fen.title"), formatError(lang, err))))
+		return
+	}
+
+	results, err := newEngine().Analyze(game, c_ANALYSIS_LINES)
+	if err != nil {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "analysis.error.title"), formatError(lang, err))))
+		return
+	}
+
+	// Number the best moves on the board, each line is shown as it ends from the same point of view
+	t := userTheme(m.Author.ID)
+	markers := make(map[uint8]string)
+	var lines []*discordgo.MessageEmbedField
+	for i := len(results) - 1; i >= 0; i-- {
+		markers[results[i].Move.To().Index] = numbersSlice[i]
+	}
+	for i, result := range results {
+		line, end := formatLine(result.PV, game)
+		board := boardFor(&end, game.Turn)
+		lines = append(lines, &discordgo.MessageEmbedField{
+			Name:  numbersSlice[i] + " " + logic.FormatSequence(result.Move, &game) + " (" + formatScore(lang, result.Score) + ")",
+			Value: line + "\n" + formatBoard(&board, r, nil, t),
+		})
+	}
+
+	l.message(s.ChannelMessageSendEmbed(m.ChannelID, &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "analysis.title", variantTitle(lang, r)),
+		Description: i18n.N(lang, "analysis.description", results[0].Depth, formatColor(lang, game.Turn), logic.FormatFEN(&game)),
+		Color:       c_BLUE,
+		Fields: append([]*discordgo.MessageEmbedField{
+			{
+				Name:  i18n.T(lang, "game.board"),
+				Value: formatBoard(&game.Board, r, markers, t),
+			},
+		}, lines...),
+	}))
+}
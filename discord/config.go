@@ -0,0 +1,280 @@
+package discord
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/render"
+)
+
+// Handlers/Functions for the settings of each server
+
+// The prefix of every command unless a server chose another one
+const c_PREFIX = "!checkers"
+
+// Settings a server chose, the zero value is the default settings
+type guildConfig struct {
+	Prefix           string   // The prefix of commands, empty for !checkers
+	InviteChannels   []string // The channels invites can be sent in, empty for every channel
+	Variant          string   // The variant played when an invite doesn't choose one, empty for american checkers
+	TimeControl      string   // The time control of games as minutes+increment, empty for no time limit
+	SpectatorChannel string   // The channel every game is shown in for others to watch, empty for none
+	ReportChannel    string   // The channel the report of every game is posted in as well as sent to the players, empty for none
+	AIGames          bool     // If players can start games against the bot
+	Theme            string   // The theme of boards posted in the server, empty for classic
+	Emojis           []string // The custom emojis of the custom theme: blue men, red men, blue kings and red kings
+	Language         string   // The language the bot talks in to members who didn't choose one, empty for english
+}
+
+// Gets the settings of a server, DMs always have the default settings
+func getGuildConfig(guildID string) guildConfig {
+	var config guildConfig
+	if guildID != "" {
+		store.Get("guilds", guildID, &config)
+	}
+	return config
+}
+
+// Gets the prefix of commands in a server
+func (c guildConfig) prefix() string {
+	if c.Prefix == "" {
+		return c_PREFIX
+	}
+	return c.Prefix
+}
+
+// Gets the variant played when an invite doesn't choose one
+func (c guildConfig) rules() logic.Rules {
+	if r, err := logic.GetRules(c.Variant); err == nil {
+		return r
+	}
+	return logic.Variants[0]
+}
+
+// Gets the time control of new games
+func (c guildConfig) timeControl() games.TimeControl {
+	control, _ := games.ParseTimeControl(c.TimeControl)
+	return control
+}
+
+// Checks if invites can be sent in a channel
+func (c guildConfig) allowsInvites(channelID string) bool {
+	if len(c.InviteChannels) == 0 {
+		return true
+	}
+	for _, id := range c.InviteChannels {
+		if id == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// Formats a channel so Discord shows its name
+func formatChannel(channelID string) string {
+	return "<#" + channelID + ">"
+}
+
+// Gets the ID of a mentioned channel
+func parseChannel(arg string) (string, bool) {
+	if !strings.HasPrefix(arg, "<#") || !strings.HasSuffix(arg, ">") {
+		return "", false
+	}
+	return arg[2 : len(arg)-1], true
+}
+
+// Describes the settings of a server
+func configEmbed(lang string, config guildConfig) *discordgo.MessageEmbed {
+	channels := i18n.T(lang, "config.invites.all")
+	if len(config.InviteChannels) != 0 {
+		var mentions []string
+		for _, id := range config.InviteChannels {
+			mentions = append(mentions, formatChannel(id))
+		}
+		channels = strings.Join(mentions, " ")
+	}
+	spectators := i18n.T(lang, "config.none")
+	if config.SpectatorChannel != "" {
+		spectators = formatChannel(config.SpectatorChannel)
+	}
+	reports := i18n.T(lang, "config.none")
+	if config.ReportChannel != "" {
+		reports = formatChannel(config.ReportChannel)
+	}
+	timeControl := i18n.T(lang, "config.time.off")
+	if control := config.timeControl(); control.Timed() {
+		timeControl = control.String()
+	}
+	ai := i18n.T(lang, "config.off")
+	if config.AIGames {
+		ai = i18n.T(lang, "config.on")
+	}
+	theme := render.CLASSIC
+	if config.Theme != "" {
+		theme = config.Theme
+	}
+	emojis := i18n.T(lang, "config.none")
+	if len(config.Emojis) != 0 {
+		emojis = strings.Join(config.Emojis, " ")
+	}
+
+	language := i18n.Name(i18n.DEFAULT)
+	if config.Language != "" {
+		language = i18n.Name(config.Language)
+	}
+
+	p := config.prefix()
+	return &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "config.title"),
+		Description: i18n.T(lang, "config.description", p),
+		Color:       c_BLUE,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: i18n.T(lang, "config.prefix.title"), Value: i18n.T(lang, "config.prefix", p)},
+			{Name: i18n.T(lang, "config.invites.title"), Value: i18n.T(lang, "config.invites", channels, p)},
+			{Name: i18n.T(lang, "config.variant.title"), Value: i18n.T(lang, "config.variant", variantTitle(lang, config.rules()), p)},
+			{Name: i18n.T(lang, "config.time.title"), Value: i18n.T(lang, "config.time", timeControl, p)},
+			{Name: i18n.T(lang, "config.spectators.title"), Value: i18n.T(lang, "config.spectators", spectators, p)},
+			{Name: i18n.T(lang, "config.reports.title"), Value: i18n.T(lang, "config.reports", reports, p)},
+			{Name: i18n.T(lang, "config.ai.title"), Value: i18n.T(lang, "config.ai", ai, p)},
+			{Name: i18n.T(lang, "config.theme.title"), Value: i18n.T(lang, "config.theme", theme, p)},
+			{Name: i18n.T(lang, "config.emojis.title"), Value: i18n.T(lang, "config.emojis", emojis, p)},
+			{Name: i18n.T(lang, "config.language.title"), Value: i18n.T(lang, "config.language", language, p, "`"+strings.Join(i18n.Codes(), "`, `")+"`")},
+		},
+	}
+}
+
+// Changes one setting from the arguments of the config command
+func changeConfig(lang string, config *guildConfig, setting string, values []string) string {
+	if len(values) == 0 {
+		return i18n.T(lang, "config.error.value")
+	}
+
+	switch setting {
+	case "prefix":
+		if strings.ContainsAny(values[0], " \n") {
+			return i18n.T(lang, "config.error.prefix")
+		}
+		config.Prefix = values[0]
+		if config.Prefix == c_PREFIX {
+			config.Prefix = ""
+		}
+	case "invites":
+		if values[0] == "all" {
+			config.InviteChannels = nil
+			return ""
+		}
+		var channels []string
+		for _, v := range values {
+			id, ok := parseChannel(v)
+			if !ok {
+				return i18n.T(lang, "config.error.channels")
+			}
+			channels = append(channels, id)
+		}
+		config.InviteChannels = channels
+	case "variant":
+		r, err := logic.GetRules(values[0])
+		if err != nil {
+			return i18n.T(lang, "config.error.variant", variantNames())
+		}
+		config.Variant = r.Name()
+	case "time":
+		control, err := games.ParseTimeControl(values[0])
+		if err != nil {
+			return i18n.T(lang, "config.error.time")
+		}
+		config.TimeControl = ""
+		if control.Timed() {
+			config.TimeControl = control.String()
+		}
+	case "spectators":
+		if values[0] == "off" {
+			config.SpectatorChannel = ""
+			return ""
+		}
+		id, ok := parseChannel(values[0])
+		if !ok {
+			return i18n.T(lang, "config.error.channel")
+		}
+		config.SpectatorChannel = id
+	case "reports":
+		if values[0] == "off" {
+			config.ReportChannel = ""
+			return ""
+		}
+		id, ok := parseChannel(values[0])
+		if !ok {
+			return i18n.T(lang, "config.error.channel")
+		}
+		config.ReportChannel = id
+	case "ai":
+		if values[0] != "on" && values[0] != "off" {
+			return i18n.T(lang, "config.error.ai")
+		}
+		config.AIGames = values[0] == "on"
+	case "theme":
+		if _, ok := emojiThemes[values[0]]; !ok && values[0] != render.CUSTOM {
+			return i18n.T(lang, "config.error.theme", formatThemeNames())
+		}
+		config.Theme = values[0]
+	case "emojis":
+		if len(values) != 4 {
+			return i18n.T(lang, "config.error.emojis")
+		}
+		for _, e := range values {
+			if !customEmoji.MatchString(e) {
+				return i18n.T(lang, "config.error.emoji")
+			}
+		}
+		config.Emojis = values
+	case "language":
+		if !i18n.Supported(values[0]) {
+			return i18n.T(lang, "config.error.language", "`"+strings.Join(i18n.Codes(), "`, `")+"`")
+		}
+		config.Language = strings.ToLower(values[0])
+	default:
+		return i18n.T(lang, "config.error.setting")
+	}
+	return ""
+}
+
+// Shows or changes the settings of a server
+func configCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+	l := newLog("command:config", "", m.GuildID, m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
+	if m.GuildID == "" {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.invalid"), i18n.T(lang, "config.error.guild"))))
+		return
+	}
+
+	permissions, err := s.UserChannelPermissions(m.Author.ID, m.ChannelID)
+	if !l.check(err) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "config.error.permissions"))))
+		return
+	}
+	if permissions&discordgo.PermissionManageServer == 0 {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "config.error.permission.title"), i18n.T(lang, "config.error.permission"))))
+		return
+	}
+
+	config := getGuildConfig(m.GuildID)
+	if len(args) < 2 {
+		l.message(s.ChannelMessageSendEmbed(m.ChannelID, configEmbed(lang, config)))
+		return
+	}
+
+	if message := changeConfig(lang, &config, strings.ToLower(args[1]), args[2:]); message != "" {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "config.error.title"), i18n.T(lang, "config.error", message, config.prefix()))))
+		return
+	}
+	if err := store.Put("guilds", m.GuildID, config); err != nil {
+		l.Error("Could not save the settings", "err", err)
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "config.error.save"))))
+		return
+	}
+	// The language of the server may have just changed
+	l.message(s.ChannelMessageSendEmbed(m.ChannelID, configEmbed(languageOf(m.Author.ID, m.GuildID), config)))
+}
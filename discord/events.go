@@ -0,0 +1,201 @@
+package discord
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Handlers/Functions that send the boards and messages that follow a move, wherever it was made
+
+// The board a move was made on with reactions, passed along with the events the move causes
+type reactionOrigin struct {
+	ChannelID  string      // The DM the move was made in
+	MessageID  string      // The board the reaction was on
+	OpponentID string      // The player who didn't make the move
+	Details    gameDetails // The details of the game from the board
+}
+
+// Gets the game on a board, the player to move is the user who reacted
+func gameOf(game *logic.Game, details gameDetails, userID string, opponentID string) games.Game {
+	return games.Game{
+		ID:      details.Game,
+		Variant: game.Rules().Name(),
+		Turn:    game.Turn,
+		Board:   game.Board,
+		Players: map[uint8]games.Player{
+			game.Turn:             {DiscordID: userID},
+			otherColor(game.Turn): {DiscordID: opponentID},
+		},
+		Moves:  details.Moves,
+		Casual: details.Casual,
+	}
+}
+
+// Gets the details to put on the boards sent after an event
+func detailsOf(c games.Context) gameDetails {
+	details := gameDetails{Casual: c.Game.Casual, Hints: c.Game.Casual}
+	if o, ok := c.Origin.(reactionOrigin); ok {
+		details = o.Details
+	}
+	details.Game, details.Moves = c.Game.ID, c.Game.Moves
+	return details
+}
+
+// Handles every game event
+func gameEventHandler(s *discordgo.Session, e games.Event) {
+	switch e := e.(type) {
+	case games.MultiJumpPending:
+		multiJumpHandler(s, e)
+	case games.MoveApplied:
+		moveAppliedHandler(s, e)
+	case games.TurnChanged:
+		turnChangedHandler(s, e)
+	case games.GameWon:
+		gameWonHandler(s, e)
+	}
+}
+
+// Shows the jumps the piece can continue with
+func multiJumpHandler(s *discordgo.Session, e games.MultiJumpPending) {
+	o, ok := e.Origin.(reactionOrigin)
+	if !ok {
+		return
+	}
+
+	// Selects the piece at the updated location and provides only the jumps it can continue with
+	game := e.Game.Position()
+	game.Selected = e.Index
+	square, _ := logic.SquareAtIndex(e.Index, &game)
+	jumps, _ := square.GetAvailableMoves(&game)
+	selectPiece(s, eventLog("event:multi_jump", e.Game), "", o.ChannelID, o.MessageID, e.Game.Players[e.Player].DiscordID, o.OpponentID, &game, detailsOf(e.Context), &square, &jumps, true)
+}
+
+// Confirms a move with the player who made it
+func moveAppliedHandler(s *discordgo.Session, e games.MoveApplied) {
+	l := eventLog("event:move_applied", e.Game)
+	updateSpectators(s, e.Game, nil)
+
+	// Both players get a message once the game is over instead
+	if e.Game.Over() {
+		return
+	}
+
+	if o, ok := e.Origin.(reactionOrigin); ok {
+		// Confirm with the current player that their move went through
+		lang := languageOf(e.Game.Players[e.Player].DiscordID, "")
+		l.message(s.ChannelMessageSend(o.ChannelID, successMessage(i18n.T(lang, "move.sent.title"), i18n.T(lang, "move.sent"))))
+
+		// Keep a record of the move, the board is shown as the player who moved sees it
+		game := e.Game.Position()
+		logic.SwapTurn(&game)
+		queueEdit(s, o.ChannelID, o.MessageID, gameEmbed(s, "", "", e.Game.Players[e.Player].DiscordID, o.OpponentID, &game, detailsOf(e.Context), nil, true))
+		return
+	}
+
+	if mover := e.Game.Players[e.Player]; e.Source == games.SOURCE_WEB && mover.DiscordID != "" {
+		dm, err := s.UserChannelCreate(mover.DiscordID)
+		if !l.check(err) {
+			return
+		}
+		lang := languageOf(mover.DiscordID, "")
+		l.message(s.ChannelMessageSend(dm.ID, successMessage(i18n.T(lang, "move.sent.title"), i18n.T(lang, "move.sent.web", e.Move))))
+	}
+}
+
+// Sends the board to the player whose turn it is
+func turnChangedHandler(s *discordgo.Session, e games.TurnChanged) {
+	player, opponent := e.Game.Players[e.Turn], e.Game.Players[otherColor(e.Turn)]
+	if player.DiscordID == s.State.User.ID {
+		go playEngineMove(e.Game)
+		return
+	}
+	if player.DiscordID == "" || opponent.DiscordID == "" {
+		return
+	}
+
+	// Errors are logged and shown on the board the move was made on if there is one, in the language of the player who moved
+	l := eventLog("event:turn_changed", e.Game)
+	fail := func(id string) {
+		if o, ok := e.Origin.(reactionOrigin); ok {
+			lang := languageOf(opponent.DiscordID, "")
+			l.message(s.ChannelMessageEdit(o.ChannelID, o.MessageID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, id))))
+		}
+	}
+
+	dm, err := s.UserChannelCreate(player.DiscordID)
+	if !l.check(err) {
+		fail("error.dm.open")
+		return
+	}
+	game := e.Game.Position()
+	gamemsg, err := s.ChannelMessageSendEmbed(dm.ID, gameEmbed(s, dm.GuildID, "select", player.DiscordID, opponent.DiscordID, &game, detailsOf(e.Context), nil, false))
+	if !l.check(err) {
+		fail("error.dm.send")
+		return
+	}
+	addSelectReactions(s, gamemsg.ChannelID, gamemsg.ID, &game)
+}
+
+// Describes why a game ended
+func formatReason(lang string, e games.GameWon) string {
+	switch e.Reason {
+	case games.RESIGNED:
+		return i18n.T(lang, "game.reason.resigned", formatColor(lang, otherColor(e.Winner)))
+	case games.NO_PIECES:
+		return i18n.T(lang, "game.reason.pieces", formatColor(lang, e.Game.Turn))
+	case games.TIMEOUT:
+		return i18n.T(lang, "game.reason.time", formatColor(lang, otherColor(e.Winner)))
+	}
+	return i18n.T(lang, "game.reason.moves", formatColor(lang, e.Game.Turn))
+}
+
+// Lets both players know who won and reviews the game, the bot doesn't message itself in games against it
+func gameWonHandler(s *discordgo.Session, e games.GameWon) {
+	updateSpectators(s, e.Game, func(lang string) string {
+		return i18n.T(lang, "spectate.won", formatColor(lang, e.Winner), formatReason(lang, e))
+	})
+	if o, ok := e.Origin.(reactionOrigin); ok {
+		queueDelete(s, o.ChannelID, o.MessageID)
+	}
+
+	l := eventLog("event:game_won", e.Game)
+	users := make(map[uint8]*discordgo.User)
+	channelIDs := make(map[uint8]string)
+	for number, p := range e.Game.Players {
+		if p.DiscordID == "" {
+			return
+		}
+		u, err := s.User(p.DiscordID)
+		if !l.check(err) {
+			return
+		}
+		users[number] = u
+		if p.DiscordID == s.State.User.ID {
+			continue
+		}
+		dm, err := s.UserChannelCreate(p.DiscordID)
+		if !l.check(err) {
+			return
+		}
+		channelIDs[number] = dm.ID
+	}
+	loser := otherColor(e.Winner)
+	sendGameOver(s, l, channelIDs[e.Winner], channelIDs[loser], users[e.Winner], users[loser], func(lang string) string {
+		return formatReason(lang, e)
+	})
+
+	// Review the game once both players know the result, and in the report channel of the server it was started in
+	var targets []reportTarget
+	for _, number := range []uint8{e.Winner, loser} {
+		if channelIDs[number] != "" {
+			targets = append(targets, reportTarget{ChannelID: channelIDs[number], UserID: e.Game.Players[number].DiscordID})
+		}
+	}
+	if t, ok := reportChannel(e.Game.ID); ok {
+		targets = append(targets, t)
+	}
+	game := e.Game.Position()
+	go sendReport(s, l, targets, users, game.Rules(), e.Game.Start, detailsOf(e.Context), e.Winner)
+}
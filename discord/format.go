@@ -1,72 +1,180 @@
 package discord
 
 import (
-	"math"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/jmsheff/discord-checkers/engine"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
 	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/openings"
+	"github.com/jmsheff/discord-checkers/puzzles"
+	"github.com/jmsheff/discord-checkers/render"
 
 	"github.com/bwmarrin/discordgo"
 )
 
 // Some helper "Enums"
-var ySlice []string = []string{"🇦", "🇧", "🇨", "🇩", "🇪", "🇫", "🇬", "🇭"}
-var xSlice []string = []string{"1️⃣", "2️⃣", "3️⃣", "4️⃣"}
-var directionSlice []rune = []rune{'N', 'n', 'S', 's'}
+var ySlice []string = []string{"🇦", "🇧", "🇨", "🇩", "🇪", "🇫", "🇬", "🇭", "🇮", "🇯"}
+var xSlice []string = []string{"1️⃣", "2️⃣", "3️⃣", "4️⃣", "5️⃣"}
 var movesSlice []string = []string{"↖️", "↗️", "↙️", "↘️"}
+var directionsSlice []string = []string{"direction.nw", "direction.ne", "direction.sw", "direction.se"}
+var numbersSlice []string = []string{"1️⃣", "2️⃣", "3️⃣", "4️⃣", "5️⃣", "6️⃣", "7️⃣", "8️⃣", "9️⃣", "🔟", "🟥", "🟧", "🟨", "🟩", "🟦", "🟪", "🟫"}
 
-// Make the board into emojis
-func formatBoard(board *string) string {
+// Gets the reaction for each move, moves are shown with arrows unless a direction has more than one move
+func moveMarkers(moves []logic.Move) []string {
+	markers := make([]string, len(moves))
+	for i, move := range moves {
+		for j, dir := range logic.Directions {
+			if move.Direction == dir {
+				markers[i] = movesSlice[j]
+			}
+		}
+
+		// Fall back to numbering the moves if the arrows are ambiguous
+		for _, m := range markers[:i] {
+			if m == markers[i] {
+				for j := range moves {
+					markers[j] = numbersSlice[j]
+				}
+				return markers
+			}
+		}
+	}
+
+	return markers
+}
+
+// Make the board into emojis, boards of custom emojis that don't fit in an embed field use the classic theme
+func formatBoard(board *string, r logic.Rules, markers map[uint8]string, t boardTheme) string {
 	var formatted []string
+	width := int(logic.Width(r))
 
 	for i, j := range *board {
 		// The current row
-		row := int(math.Ceil(float64(i / 4)))
+		row := i / width
 		var e string
 
 		// If we are at the start of the row
-		if (i+1)%4 == 1 {
+		if (i+1)%width == 1 {
 			formatted = append(formatted, ySlice[row])
 		}
 
 		// Get what the emoji is based on the item
 		switch j {
 		case '0':
-			e = "⬛"
-		case '1':
-			e = "🔵"
-		case '2':
-			e = "🔴"
-		case '3':
-			e = "💙"
-		case '4':
-			e = "❤️"
-		case directionSlice[0]: // Northwest
-			e = movesSlice[0]
-		case directionSlice[1]: // Northeast
-			e = movesSlice[1]
-		case directionSlice[2]: // Southwest
-			e = movesSlice[2]
-		case directionSlice[3]: // Southeast
-			e = movesSlice[3]
+			e = t.Emojis.Dark
+		case '5': // Jumped pieces that are removed once the multi jump is over
+			e = "💥"
+		case '1', '2', '3', '4':
+			e = t.Emojis.Pieces[j-'1']
+		}
+		if marker, ok := markers[uint8(i)]; ok {
+			e = marker
 		}
 
-		// If the row is even
-		if row%2 == 0 {
-			formatted = append(formatted, "⬜"+e)
+		// If the row is even, rotated boards start the row on the other color
+		if (row%2 == 0) != r.Rotated() {
+			formatted = append(formatted, t.Emojis.Light+e)
 		} else { // If the row is odd
-			formatted = append(formatted, e+"⬜")
+			formatted = append(formatted, e+t.Emojis.Light)
 		}
 
 		// If we are at the end of the row
-		if (i+1)%4 == 0 {
+		if (i+1)%width == 0 {
 			formatted = append(formatted, "\n")
 		}
 	}
 
 	// Add the bottom row of numbers
-	formatted = append(formatted, "⏺1️⃣1️⃣2️⃣2️⃣3️⃣3️⃣4️⃣4️⃣")
-	return strings.Join(formatted, "")
+	formatted = append(formatted, "⏺")
+	for _, x := range xSlice[:width] {
+		formatted = append(formatted, x+x)
+	}
+
+	result := strings.Join(formatted, "")
+	if t.Name == render.CUSTOM && utf8.RuneCountInString(result) > c_FIELD_LIMIT {
+		return formatBoard(board, r, markers, themeOf(render.CLASSIC, ""))
+	}
+	return result
+}
+
+// Gets the name of a players color
+func colorName(player uint8) string {
+	if player == c_PLAYER_RED {
+		return "red"
+	}
+	return "blue"
+}
+
+// Formats a players color in a readable format
+func formatColor(lang string, player uint8) string {
+	if player == c_PLAYER_RED {
+		return i18n.T(lang, "color.red")
+	}
+	return i18n.T(lang, "color.blue")
+}
+
+// Gets the title of a variant in a language
+func variantTitle(lang string, r logic.Rules) string {
+	return i18n.T(lang, "variant."+r.Name())
+}
+
+// Names a square by the letter of its row and the number of its column.
+// The letters are the same in every language since they have to match the reactions used to select the square
+func formatSquare(index uint8, r logic.Rules) string {
+	width := logic.Width(r)
+	return string(rune('A'+index/width)) + strconv.Itoa(int(index%width)+1)
+}
+
+// Describes the moves marked on a board in words, in the order of the reactions
+func formatMoves(lang string, markers map[uint8]string, r logic.Rules) string {
+	var indexes []uint8
+	for index := range markers {
+		indexes = append(indexes, index)
+	}
+	order := func(marker string) int {
+		for i, m := range append(movesSlice, numbersSlice...) {
+			if m == marker {
+				return i
+			}
+		}
+		return len(movesSlice) + len(numbersSlice)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return order(markers[indexes[i]]) < order(markers[indexes[j]]) })
+
+	var lines []string
+	for _, index := range indexes {
+		marker := markers[index]
+		line := i18n.T(lang, "game.move", marker, formatSquare(index, r))
+		for i, m := range movesSlice {
+			if m == marker {
+				line = i18n.T(lang, "game.move.direction", marker, i18n.T(lang, directionsSlice[i]), formatSquare(index, r))
+			}
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Gets the color of the other player
+func otherColor(player uint8) uint8 {
+	if player == c_PLAYER_RED {
+		return c_PLAYER_BLUE
+	}
+	return c_PLAYER_RED
+}
+
+// Formats the time left on a clock as minutes and seconds
+func formatClock(d time.Duration) string {
+	seconds := int(d / time.Second)
+	return strconv.Itoa(seconds/60) + ":" + fmt.Sprintf("%02d", seconds%60)
 }
 
 // Formats the user in a readable format
@@ -79,81 +187,185 @@ func errorMessage(title string, message string) string {
 	return "❌  **" + title + "**\n" + message
 }
 
+// The message each error shown to users is translated with
+var errorMessages = map[error]string{
+	errNoMessages:        "error.messages",
+	errNoGame:            "error.channel.game",
+	games.ErrNotFound:    "error.game",
+	games.ErrOver:        "game.over",
+	games.ErrStale:       "error.stale",
+	games.ErrTurn:        "error.turn",
+	logic.ErrCoordinates: "error.coordinates",
+	logic.ErrEmpty:       "error.empty",
+	logic.ErrOpponent:    "error.piece",
+	logic.ErrKeepJumping: "error.jump.same",
+	logic.ErrOtherJump:   "error.jump.other",
+	logic.ErrNowhere:     "error.nowhere",
+	logic.ErrFEN:         "error.fen",
+	logic.ErrNotation:    "error.notation",
+	logic.ErrImpossible:  "error.impossible",
+	logic.ErrAmbiguous:   "error.ambiguous",
+	logic.ErrVariant:     "error.variant",
+	engine.ErrNoMoves:    "error.moves",
+}
+
+// Describes an error in a language, errors without a message are described as a bot error
+func formatError(lang string, err error) string {
+	for e, id := range errorMessages {
+		if errors.Is(err, e) {
+			return i18n.T(lang, id)
+		}
+	}
+	return i18n.T(lang, "error.unknown")
+}
+
 // Generic message format for successful operations
 func successMessage(title string, message string) string {
 	return "✅  **" + title + "**\n" + message
 }
 
-// Creates an embed for the game
-func gameEmbed(s *discordgo.Session, cmd string, opponentID string, game *logic.Game, board string, spectate bool) *discordgo.MessageEmbed {
+// Creates an embed for the game, the commands on it use the prefix of the server it is posted in.
+// Boards are sent in DMs where the guild ID is empty and commands use the default prefix
+func gameEmbed(s *discordgo.Session, guildID string, cmd string, userID string, opponentID string, game *logic.Game, details gameDetails, markers map[uint8]string, spectate bool) *discordgo.MessageEmbed {
+	lang := languageOf(userID, "")
+	prefix := getGuildConfig(guildID).prefix()
 	opponent, err := s.User(opponentID)
-	if err != nil {
+	if !newLog("embed", details.Game, "", userID, opponentID).check(err) {
 		return &discordgo.MessageEmbed{
 			Color:       c_RED,
-			Description: "Error getting opponent",
+			Description: i18n.T(lang, "error.opponent"),
 		}
 	}
 
 	// Regular values
 	color := c_BLUE
-	status := "Your move"
-	help := "For help type `!checkers help " + cmd + "`"
-	cmdAndArgs := cmd + ":" + StringifyGame(opponentID, game)
+	status := i18n.T(lang, "game.status.turn")
+	help := i18n.T(lang, "game.help.topic", prefix, cmd)
+	cmdAndArgs := cmd + ":" + StringifyGame(opponentID, game) + " " + stringifyDetails(details, game)
 	if spectate {
 		// Spectator mode values
 		color = c_DEFAULT
-		status = "Waiting for opponent..."
-		help = "For help type `!checkers help`"
-		cmdAndArgs = "" // Ensures reactions won't do anything on old messages
+		status = i18n.T(lang, "game.status.waiting")
+		help = i18n.T(lang, "game.help.topics", prefix)
+		cmdAndArgs = "spectate:" + StringifyGame(opponentID, game) + " " + stringifyDetails(details, game) // Keeps the position for adjudication, reactions won't do anything on old messages
 	}
 
-	// Shows the captured pieces
+	// Shows the captured pieces in the theme of the player
+	t := userTheme(userID)
 	p1score, p2score := logic.GetScore(game)
 	var capturedPieces1 []string
 	var capturedPieces2 []string
 	for i := 0; i < p1score; i++ {
-		capturedPieces1 = append(capturedPieces1, "🔴")
+		capturedPieces1 = append(capturedPieces1, t.Emojis.Pieces[c_PLAYER_RED-1])
 	}
 	for i := 0; i < p2score; i++ {
-		capturedPieces2 = append(capturedPieces2, "🔵")
+		capturedPieces2 = append(capturedPieces2, t.Emojis.Pieces[c_PLAYER_BLUE-1])
 	}
 
 	// If there are no captured pieces set it to none so the embed is valid
 	if len(capturedPieces1) == 0 {
-		capturedPieces1 = []string{"None"}
+		capturedPieces1 = []string{i18n.T(lang, "game.none")}
 
 	}
 	if len(capturedPieces2) == 0 {
-		capturedPieces2 = []string{"None"}
-	}
-
-	return &discordgo.MessageEmbed{
-		Color: color,
-		Title: "Checkers game against " + formatUser(opponent),
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:  "Status",
-				Value: status,
-			},
-			{
-				Name:  "Captured Red pieces",
-				Value: strings.Join(capturedPieces1, ""),
-			},
-			{
-				Name:  "Captured Blue pieces",
-				Value: strings.Join(capturedPieces2, ""),
-			},
-			{
-				Name:  "Board",
-				Value: formatBoard(&board),
-			},
-			{
-				Name:  "Help",
-				Value: help,
-			},
+		capturedPieces2 = []string{i18n.T(lang, "game.none")}
+	}
+
+	// Name the opening while the game is still in one
+	description := i18n.T(lang, "game.playing", formatColor(lang, game.Turn))
+	if t.Name != render.CLASSIC {
+		description += "\n" + i18n.T(lang, "game.pieces", t.Emojis.Pieces[game.Turn-1], t.Emojis.Pieces[game.Turn+1])
+	}
+	if opening := openings.Name(game); opening != "" {
+		description += "\n" + i18n.T(lang, "game.opening", opening)
+	}
+	if details.Casual {
+		casual := i18n.T(lang, "game.casual")
+		if details.Hints {
+			casual = i18n.T(lang, "game.casual.hints", prefix)
+		}
+		description += "\n" + casual
+	}
+
+	// Boards shown as images are linked from the web board
+	board := formatBoard(&game.Board, game.Rules(), markers, t)
+	image := boardImageURL(game.Board, game.Rules(), markers, t)
+	if image != "" {
+		board = i18n.T(lang, "game.image")
+	}
+
+	title := i18n.T(lang, "game.title", variantTitle(lang, game.Rules()), formatUser(opponent))
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:  i18n.T(lang, "game.status"),
+			Value: status,
+		},
+		{
+			Name:  i18n.N(lang, "game.captured.red", p1score),
+			Value: strings.Join(capturedPieces1, ""),
+		},
+		{
+			Name:  i18n.N(lang, "game.captured.blue", p2score),
+			Value: strings.Join(capturedPieces2, ""),
 		},
+		{
+			Name:  i18n.T(lang, "game.board"),
+			Value: board,
+		},
+		{
+			Name:  i18n.T(lang, "game.help"),
+			Value: help,
+		},
+	}
+
+	// The moves of a selected piece are written out too, the arrows alone don't say where they go
+	if cmd == "move" && len(markers) != 0 {
+		fields = append(fields[:4], &discordgo.MessageEmbedField{Name: i18n.T(lang, "game.moves"), Value: formatMoves(lang, markers, game.Rules())}, fields[4])
+	}
+
+	// Puzzles don't start with every piece so the captured pieces aren't shown
+	if p, err := puzzles.Get(details.Puzzle); err == nil {
+		title = i18n.T(lang, "game.puzzle.title", details.Puzzle, p.Theme)
+		description = i18n.T(lang, "game.puzzle", formatColor(lang, game.Turn))
+		fields = append([]*discordgo.MessageEmbedField{fields[0]}, fields[3:]...)
+	}
+
+	// Screen readers get the position, the last move and the moves that can be made in words instead of emojis
+	if isAccessible(userID) {
+		if last := describeLastMove(lang, details.Moves); last != "" {
+			description += "\n" + last
+		}
+		text := []*discordgo.MessageEmbedField{fields[0], {Name: i18n.T(lang, "game.board"), Value: describePosition(lang, game)}}
+		if cmd == "move" && len(markers) != 0 {
+			text = append(text, &discordgo.MessageEmbedField{Name: i18n.T(lang, "game.moves"), Value: formatMoves(lang, markers, game.Rules())})
+		} else if cmd == "select" && !spectate {
+			fields[0].Value += "\n" + i18n.T(lang, "accessible.play", prefix)
+			text = append(text, linesFields(i18n.T(lang, "game.moves"), describeMoves(lang, game))...)
+		}
+		fields = append(text, fields[len(fields)-1])
+		image = ""
+	}
+
+	// Timed games show the time each player had left when the board was sent
+	if details.Game != "" {
+		if g, ok := gameService.Store.Get(details.Game); ok && g.TimeControl.Timed() {
+			now := time.Now()
+			clocks := formatColor(lang, c_PLAYER_BLUE) + " " + formatClock(g.Remaining(c_PLAYER_BLUE, now)) + "  " + formatColor(lang, c_PLAYER_RED) + " " + formatClock(g.Remaining(c_PLAYER_RED, now))
+			fields = append([]*discordgo.MessageEmbedField{fields[0], {Name: i18n.T(lang, "game.clock", g.TimeControl.String()), Value: clocks}}, fields[1:]...)
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Color:       color,
+		Title:       title,
+		Description: description,
+		Fields:      fields,
 		Footer: &discordgo.MessageEmbedFooter{
 			Text: cmdAndArgs,
 		},
 	}
+	if image != "" {
+		embed.Image = &discordgo.MessageEmbedImage{URL: image}
+	}
+	return embed
 }
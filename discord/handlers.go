@@ -2,8 +2,11 @@ package discord
 
 import (
 	"strings"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/metrics"
 )
 
 // Handles all checkers commands
@@ -13,23 +16,34 @@ func CommandsHandler(s *discordgo.Session, m *discordgo.MessageCreate) {
 		return
 	}
 
-	// Ignore all messages that don't have the !checkers prefix
-	if !strings.HasPrefix(m.Content, "!checkers") {
+	// Ignore all messages that don't start with the prefix of the server as a word of its own, !checkers unless it was changed
+	prefix := getGuildConfig(m.GuildID).prefix()
+	fields := strings.Fields(m.Content)
+	if len(fields) == 0 || fields[0] != prefix {
 		return
 	}
 
 	// Get the arguments
-	args := strings.Split(m.Content, " ")[1:]
+	args := fields[1:]
 	// Ensure valid command
 	if len(args) == 0 {
-		s.ChannelMessageSend(m.ChannelID, errorMessage("Command missing", "For a list of commands type !checkers help"))
+		l := newLog("command", "", m.GuildID, m.Author.ID)
+		lang := languageOf(m.Author.ID, m.GuildID)
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "command.missing.title"), i18n.T(lang, "command.missing", prefix))))
 		return
 	}
 
+	// Time the handler of the command, invalid commands are timed together
+	handler, start := args[0], time.Now()
+	defer func() { metrics.ObserveHandler("command:"+handler, time.Since(start)) }()
+
 	// Call the corresponding handler
 	switch args[0] {
 	case "ping":
-		s.ChannelMessageSend(m.ChannelID, "Pong!")
+		// Also shows how far behind the queues of Discord actions are
+		queue := QueueMetrics()
+		l := newLog("command:ping", "", m.GuildID, m.Author.ID)
+		l.message(s.ChannelMessageSend(m.ChannelID, i18n.T(languageOf(m.Author.ID, m.GuildID), "command.ping", queue.Depth, queue.Latency.Round(time.Millisecond).String())))
 	case "help":
 		// Help command with topic
 		if len(args) > 1 {
@@ -39,8 +53,33 @@ func CommandsHandler(s *discordgo.Session, m *discordgo.MessageCreate) {
 		}
 	case "invite":
 		inviteCommandHandler(s, m, args)
+	case "adjudicate":
+		adjudicateCommandHandler(s, m)
+	case "hint":
+		hintCommandHandler(s, m, args)
+	case "analyze":
+		analyzeCommandHandler(s, m, args)
+	case "puzzle":
+		puzzleCommandHandler(s, m, args)
+	case "web":
+		webCommandHandler(s, m)
+	case "stats":
+		statsCommandHandler(s, m)
+	case "config":
+		configCommandHandler(s, m, args)
+	case "theme":
+		themeCommandHandler(s, m, args)
+	case "language":
+		languageCommandHandler(s, m, args)
+	case "accessible":
+		accessibleCommandHandler(s, m, args)
+	case "play":
+		playCommandHandler(s, m, args)
 	default:
-		s.ChannelMessageSend(m.ChannelID, errorMessage("Invalid command", "For a list of help topics, type !checkers help"))
+		handler = "invalid"
+		l := newLog("command:invalid", "", m.GuildID, m.Author.ID)
+		lang := languageOf(m.Author.ID, m.GuildID)
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "command.invalid.title"), i18n.T(lang, "command.invalid", prefix))))
 	}
 }
 
@@ -52,9 +91,10 @@ func ReactionsHandler(s *discordgo.Session, r *discordgo.MessageReactionAdd) {
 	}
 
 	// Fetch some extra information about the message associated to the reaction
+	l := newLog("reaction", "", r.GuildID, r.UserID)
 	m, err := s.ChannelMessage(r.ChannelID, r.MessageID)
 	// Ignore reactions on messages that have an error or that have not been sent by the bot
-	if err != nil || m == nil || m.Author.ID != s.State.User.ID {
+	if !l.check(err) || m == nil || m.Author.ID != s.State.User.ID {
 		return
 	}
 
@@ -70,7 +110,7 @@ func ReactionsHandler(s *discordgo.Session, r *discordgo.MessageReactionAdd) {
 
 	user, err := s.User(r.UserID)
 	// Ignore when sender is invalid or is a bot
-	if err != nil || user == nil || user.Bot {
+	if !l.check(err) || user == nil || user.Bot {
 		return
 	}
 
@@ -80,6 +120,10 @@ func ReactionsHandler(s *discordgo.Session, r *discordgo.MessageReactionAdd) {
 		return
 	}
 
+	// Time the handler of the reaction, the footers are written by the bot so there are only a few of them
+	start := time.Now()
+	defer func() { metrics.ObserveHandler("reaction:"+args[0], time.Since(start)) }()
+
 	// Call the corresponding handler
 	switch args[0] {
 	case "invite":
@@ -1,75 +1,94 @@
 package discord
 
-import "github.com/bwmarrin/discordgo"
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Lists the names of the available variants
+func variantNames() string {
+	var names []string
+	for _, r := range logic.Variants {
+		names = append(names, "`"+r.Name()+"`")
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// Gets a help field from the messages id.title and id
+func helpField(lang string, id string, args ...interface{}) *discordgo.MessageEmbedField {
+	return &discordgo.MessageEmbedField{
+		Name:  i18n.T(lang, id+".title"),
+		Value: i18n.T(lang, id, args...),
+	}
+}
 
 func helpCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate, topic string) {
-	var title string
-	var description string
+	l := newLog("command:help", "", m.GuildID, m.Author.ID)
 	var fields []*discordgo.MessageEmbedField
+	lang := languageOf(m.Author.ID, m.GuildID)
+	// Servers can use another prefix than !checkers
+	p := getGuildConfig(m.GuildID).prefix()
 
 	switch topic {
 	case "invites":
-		title = "✉️  Invites - Checkers Help"
-		description = "Invites allow you to start a game with a player. Invites CANNOT be sent:\n  • Through DM\n  • By or to bots\n  • To yourself\nSee below for available commands."
 		fields = []*discordgo.MessageEmbedField{
-			{
-				Name:  "General invites",
-				Value: "`!checkers invite`: Sends an invite to the channel the command was sent in for any user to accept",
-			},
-			{
-				Name:  "Direct invites",
-				Value: "`!checkers invite @<user>`: Sends an invite directly to the mentioned user.",
-			},
+			helpField(lang, "help.invites.general", p),
+			helpField(lang, "help.invites.direct", p),
+			helpField(lang, "help.invites.colors"),
+			helpField(lang, "help.invites.ballots"),
+			helpField(lang, "help.invites.casual"),
+			helpField(lang, "help.invites.variants", variantNames()),
 		}
 	case "select":
-		title = "⏺  Selection - Checkers Help"
-		description = "Selection is done through reactions. Valid selection reactions are set by the bot. Select a piece by reacting with the corresponding emojis."
 		fields = []*discordgo.MessageEmbedField{
-			{
-				Name:  "Example",
-				Value: "To select the piece at the square F1 react with  🇫  followed by  1️⃣ .",
-			},
-			{
-				Name:  "Confirmation",
-				Value: "Confirm a selection by reacting with a  ✅ . This will validate your selection and show the available moves for the selected piece on the board.",
-			},
+			helpField(lang, "help.select.example"),
+			helpField(lang, "help.select.confirm"),
 		}
 	case "move":
-		title = "↗️  Movement - Checkers Help"
-		description = "Movement is done through reactions. Valid movement reactions are set by the bot. The moves for the piece you selected are shown on the board. Move the piece by reacting with the corresponding emoji."
 		fields = []*discordgo.MessageEmbedField{
-			{
-				Name:  "Example",
-				Value: "To move the selected piece to the square Northeast of itself, react with a  ↗️",
-			},
-			{
-				Name:  "Cancel",
-				Value: "To select a different piece, react with a  ❌ . This will bring you back to the selection step.",
-			},
+			helpField(lang, "help.move.example"),
+			helpField(lang, "help.move.numbered"),
+			helpField(lang, "help.move.cancel"),
+		}
+	case "analysis":
+		fields = []*discordgo.MessageEmbedField{
+			helpField(lang, "help.analysis.hints", p),
+			helpField(lang, "help.analysis.off", p),
+			helpField(lang, "help.analysis.analyze", p),
+		}
+	case "puzzles":
+		fields = []*discordgo.MessageEmbedField{
+			helpField(lang, "help.puzzles.start", p),
+			helpField(lang, "help.puzzles.daily", p),
+			helpField(lang, "help.puzzles.choose", p),
+			helpField(lang, "help.puzzles.rating", p),
 		}
 	default:
-		title = "ℹ️  Topics - Checkers Help"
-		description = "Pick a topic below to get help"
+		topic = "topics"
 		fields = []*discordgo.MessageEmbedField{
-			{
-				Name:  "✉️  Invites",
-				Value: "`!checkers help invites`:  Gives instruction on how to send invites ",
-			},
-			{
-				Name:  "⏺  Selection",
-				Value: "`!checkers help select`: Gives help on how to select a piece",
-			},
-			{
-				Name:  "↗️  Movement",
-				Value: "`!checkers help move`: Provides help on how to move a piece",
-			},
+			helpField(lang, "help.topics.invites", p),
+			helpField(lang, "help.topics.select", p),
+			helpField(lang, "help.topics.move", p),
+			helpField(lang, "help.topics.analysis", p),
+			helpField(lang, "help.topics.puzzles", p),
+			helpField(lang, "help.topics.web", p),
+			helpField(lang, "help.topics.theme", p),
+			helpField(lang, "help.topics.language", p, formatLanguages()),
+			helpField(lang, "help.topics.accessible", p),
+			helpField(lang, "help.topics.config", p),
+			helpField(lang, "help.topics.stats", p),
+			helpField(lang, "help.topics.adjudicate", p),
 		}
 	}
 
-	s.ChannelMessageSendEmbed(m.ChannelID, &discordgo.MessageEmbed{
-		Title:       title,
-		Description: description,
+	l.message(s.ChannelMessageSendEmbed(m.ChannelID, &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "help."+topic+".title"),
+		Description: i18n.T(lang, "help."+topic),
 		Fields:      fields,
 		Color:       c_BLUE,
-	})
+	}))
 }
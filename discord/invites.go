@@ -1,143 +1,356 @@
 package discord
 
 import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"strings"
+
 	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
 	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/openings"
 )
 
 // Handlers/Functions for everything invite related
 
+// Options chosen when sending an invite
+type inviteOptions struct {
+	Color  uint8             // The color the sender plays as
+	Rules  logic.Rules       // The variant to play
+	Ballot int               // The three move ballot to start from, 0 for none
+	Casual bool              // If the game is casual, casual games allow hints
+	AI     bool              // If the game is against the bot
+	Guild  string            // The server the invite was sent in
+	Time   games.TimeControl // The time each player gets for the game
+}
+
+// Gets the options from the invite arguments, defaults to the sender playing blue in the default variant of the server.
+// Errors are written in the given language
+func parseInviteOptions(cmd []string, guildID string, lang string) (inviteOptions, error) {
+	config := getGuildConfig(guildID)
+	options := inviteOptions{Color: c_PLAYER_BLUE, Rules: config.rules(), Guild: guildID, Time: config.timeControl()}
+	ballot := false
+	for _, arg := range cmd[1:] {
+		// Skip mentions, they are handled separately
+		if strings.HasPrefix(arg, "<@") || arg == "" {
+			continue
+		}
+
+		if strings.HasPrefix(strings.ToLower(arg), "variant:") {
+			r, err := logic.GetRules(arg[len("variant:"):])
+			if err != nil {
+				return inviteOptions{}, errors.New(i18n.T(lang, "invite.error.variant"))
+			}
+			options.Rules = r
+			continue
+		}
+
+		switch strings.ToLower(arg) {
+		case "red":
+			options.Color = c_PLAYER_RED
+		case "blue":
+			options.Color = c_PLAYER_BLUE
+		case "random":
+			options.Color = uint8(rand.Intn(2)) + 1
+		case "ballot":
+			ballot = true
+		case "casual":
+			options.Casual = true
+		case "ai":
+			options.AI = true
+		default:
+			return inviteOptions{}, errors.New(i18n.T(lang, "invite.error.option"))
+		}
+	}
+
+	// Ballots are only played in american checkers
+	if ballot {
+		if options.Rules.Name() != (logic.American{}).Name() {
+			return inviteOptions{}, errors.New(i18n.T(lang, "invite.error.ballot"))
+		}
+		options.Ballot = openings.RandomBallot()
+	}
+
+	return options, nil
+}
+
+// Gets the sender ID and the options from an invite footer
+func parseInvite(s string) (string, inviteOptions) {
+	values := strings.Split(s, " ")
+	// Invites sent before colors could be chosen always had the sender play blue
+	options := inviteOptions{Color: c_PLAYER_BLUE, Rules: logic.Variants[0]}
+	if len(values) > 1 && values[1] == colorName(c_PLAYER_RED) {
+		options.Color = c_PLAYER_RED
+	}
+
+	// Invites sent before variants could be chosen are always american checkers
+	if len(values) > 2 {
+		if r, err := logic.GetRules(values[2]); err == nil {
+			options.Rules = r
+		}
+	}
+
+	if len(values) > 3 {
+		options.Ballot, _ = strconv.Atoi(values[3])
+	}
+
+	if len(values) > 4 {
+		options.Casual = values[4] == "casual"
+	}
+
+	// Invites sent before servers had settings have no server or time control
+	if len(values) > 6 {
+		if values[5] != "-" {
+			options.Guild = values[5]
+		}
+		options.Time, _ = games.ParseTimeControl(values[6])
+	}
+
+	return values[0], options
+}
+
+// Stringifies the options of an invite for the footer
+func stringifyInviteOptions(options inviteOptions) string {
+	mode := "standard"
+	if options.Casual {
+		mode = "casual"
+	}
+	guild := options.Guild
+	if guild == "" {
+		guild = "-"
+	}
+	return colorName(options.Color) + " " + options.Rules.Name() + " " + strconv.Itoa(options.Ballot) + " " + mode + " " + guild + " " + options.Time.String()
+}
+
+// Describes the options of an invite for the player with the given color
+func formatInviteOptions(lang string, options inviteOptions, color uint8) string {
+	description := i18n.T(lang, "invite.variant", variantTitle(lang, options.Rules))
+	if options.Ballot != 0 {
+		description += "\n" + i18n.T(lang, "invite.ballot", openings.Ballots[options.Ballot-1])
+	}
+	if options.Casual {
+		description += "\n" + i18n.T(lang, "invite.casual")
+	}
+	if options.Time.Timed() {
+		description += "\n" + i18n.T(lang, "invite.time", options.Time.String())
+	}
+
+	return description + "\n" + i18n.T(lang, "invite.color", formatColor(lang, color))
+}
+
 // Sends a invite to game to a users DM
-func sendDirectInvite(s *discordgo.Session, m *discordgo.MessageCreate, recipient *discordgo.User) {
+func sendDirectInvite(s *discordgo.Session, m *discordgo.MessageCreate, recipient *discordgo.User, options inviteOptions) {
+	l := newLog("command:invite", "", m.GuildID, m.Author.ID, recipient.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
 	if m.Author.ID == recipient.ID {
-		s.ChannelMessageSend(m.ChannelID, errorMessage("Invalid recipient", "Cannot play against yourself!"))
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "invite.error.recipient"), i18n.T(lang, "invite.error.yourself"))))
 		return
 	}
 
 	if recipient.Bot {
-		s.ChannelMessageSend(m.ChannelID, errorMessage("Invalid recipient", "Cannot play against bot!"))
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "invite.error.recipient"), i18n.T(lang, "invite.error.bot"))))
 		return
 	}
 
 	dm, err := s.UserChannelCreate(recipient.ID)
-	if err != nil {
-		s.ChannelMessageSend(m.ChannelID, errorMessage("Bot error", "Error creating direct message."))
+	if !l.check(err) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "error.dm"))))
 		return
 	}
 
+	// The invite is written in the language of the recipient
+	recipientLang := languageOf(recipient.ID, m.GuildID)
 	invite, err := s.ChannelMessageSendEmbed(dm.ID, &discordgo.MessageEmbed{
-		Title:       "Checkers game invite from " + formatUser(m.Author),
-		Description: "Click the  ✅  to accept this invitation, or the  ❌  to deny.",
+		Title:       i18n.T(recipientLang, "invite.title", formatUser(m.Author)),
+		Description: i18n.T(recipientLang, "invite.direct") + "\n" + formatInviteOptions(recipientLang, options, otherColor(options.Color)),
 		Color:       c_BLUE,
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "invite:" + m.Author.ID,
+			Text: "invite:" + m.Author.ID + " " + stringifyInviteOptions(options),
 		},
 	})
 
-	if err != nil {
-		s.ChannelMessageSend(m.ChannelID, errorMessage("Bot error", "Error sending invite."))
+	if !l.check(err) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "invite.error.send"))))
 		return
 	}
 
-	s.MessageReactionAdd(dm.ID, invite.ID, "✅")
-	s.MessageReactionAdd(dm.ID, invite.ID, "❌")
+	queueReactions(s, dm.ID, invite.ID, "✅", "❌")
 
-	s.ChannelMessageSend(m.ChannelID, successMessage("Success", "Invite sent to "+formatUser(recipient)+"!"))
+	l.message(s.ChannelMessageSend(m.ChannelID, successMessage(i18n.T(lang, "invite.sent.title"), i18n.T(lang, "invite.sent", formatUser(recipient), formatColor(lang, options.Color)))))
+	sendInviteEvent(m, recipient, options)
 }
 
 // Sends a general invite for any user in the channel to accept
-func sendGeneralInvite(s *discordgo.Session, m *discordgo.MessageCreate) {
+func sendGeneralInvite(s *discordgo.Session, m *discordgo.MessageCreate, options inviteOptions) {
+	l := newLog("command:invite", "", m.GuildID, m.Author.ID)
+	// Anyone can accept so the invite is written in the language of the server
+	lang := languageOf("", m.GuildID)
 	invite, err := s.ChannelMessageSendEmbed(m.ChannelID, &discordgo.MessageEmbed{
-		Title:       "Checkers game invite from " + formatUser(m.Author),
-		Description: "Click the  ✅  to accept this invitation.",
+		Title:       i18n.T(lang, "invite.title", formatUser(m.Author)),
+		Description: i18n.T(lang, "invite.general") + "\n" + formatInviteOptions(lang, options, otherColor(options.Color)),
 		Color:       c_BLUE,
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "generalinvite:" + m.Author.ID,
+			Text: "generalinvite:" + m.Author.ID + " " + stringifyInviteOptions(options),
 		},
 	})
 
-	if err != nil {
-		s.ChannelMessageSend(m.ChannelID, errorMessage("Bot error", "Error sending invite."))
+	if !l.check(err) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "invite.error.send"))))
 		return
 	}
 
-	s.MessageReactionAdd(m.ChannelID, invite.ID, "✅")
+	queueReactions(s, m.ChannelID, invite.ID, "✅")
+	sendInviteEvent(m, nil, options)
 }
 
 // Handles all invite related commands
 func inviteCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate, cmd []string) {
+	l := newLog("command:invite", "", m.GuildID, m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
 	c, err := s.Channel(m.ChannelID)
-	if err != nil {
-		s.ChannelMessageSend(m.ChannelID, errorMessage("Bot error", "Error getting channel."))
+	if !l.check(err) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "error.channel"))))
 		return
 	}
 
 	// Ensure that the command is not being sent from a dm
 	if c.Type == discordgo.ChannelTypeDM {
-		s.ChannelMessageSend(m.ChannelID, errorMessage("Invalid channel", "Cannot send invites from a DM"))
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.invalid"), i18n.T(lang, "invite.error.dm"))))
+		return
+	}
+
+	// Servers can limit invites to some channels
+	config := getGuildConfig(m.GuildID)
+	if !config.allowsInvites(m.ChannelID) {
+		var channels []string
+		for _, id := range config.InviteChannels {
+			channels = append(channels, formatChannel(id))
+		}
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.invalid"), i18n.T(lang, "invite.error.channels", strings.Join(channels, ", ")))))
 		return
 	}
 
+	options, err := parseInviteOptions(cmd, m.GuildID, lang)
 	recipients := m.Mentions
-	if len(recipients) == 1 {
-		sendDirectInvite(s, m, recipients[0])
+	if err == nil && options.AI {
+		if !config.AIGames {
+			l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "invite.error.invalid"), i18n.T(lang, "invite.error.ai"))))
+			return
+		}
+		startAIGame(s, m, options)
+	} else if len(recipients) == 1 {
+		if err != nil {
+			l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "invite.error.options"), i18n.T(lang, "invite.error.help", err.Error(), config.prefix()))))
+			return
+		}
+		sendDirectInvite(s, m, recipients[0], options)
 	} else if len(recipients) == 0 {
-		// Ensure this is not a mistake by making sure these are the only 2 arguments
-		if len(cmd) == 1 {
-			sendGeneralInvite(s, m)
+		// Ensure this is not a mistake by making sure the only other arguments are options
+		if err == nil {
+			sendGeneralInvite(s, m, options)
 		} else {
-			s.ChannelMessageSend(m.ChannelID, errorMessage("Invalid Reciepient", "Ensure you are mentioning the player in the format of @<user>. Or, if you are trying to send a general invite leave the user blank."))
+			l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "invite.error.recipient"), i18n.T(lang, "invite.error.mention"))))
 		}
 	} else if len(recipients) > 1 {
-		s.ChannelMessageSend(m.ChannelID, errorMessage("Invalid invite", "Cannot invite multiple players!"))
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "invite.error.invalid"), i18n.T(lang, "invite.error.multiple"))))
 	}
 }
 
+// Creates the game an invite starts, red always moves first unless a ballot has already been played
+func newInviteGame(options inviteOptions) (logic.Game, gameDetails, error) {
+	game := logic.NewGame(options.Rules)
+	// Hints start turned on in casual games
+	details := gameDetails{Casual: options.Casual, Hints: options.Casual}
+	if options.Ballot != 0 {
+		var err error
+		if game, err = openings.BallotGame(options.Ballot); err != nil {
+			return logic.Game{}, gameDetails{}, err
+		}
+		details.Moves = strings.Fields(openings.Ballots[options.Ballot-1])
+	}
+
+	return game, details, nil
+}
+
 // Handles all invite related reactions
-func inviteReactionHandler(s *discordgo.Session, r *discordgo.MessageReactionAdd, m *discordgo.Message, user *discordgo.User, opponentID string, general bool) {
+func inviteReactionHandler(s *discordgo.Session, r *discordgo.MessageReactionAdd, m *discordgo.Message, user *discordgo.User, inviteString string, general bool) {
+	opponentID, options := parseInvite(inviteString)
 	// If the reaction comes from the sender of the invite(This will only happen in the case of general invites)
 	if r.UserID == opponentID {
 		return
 	}
+	l := newLog("reaction:invite", "", options.Guild, r.UserID, opponentID)
 	sender, err := s.User(opponentID)
-	if err != nil || sender == nil {
+	if !l.check(err) || sender == nil {
+		return
+	}
+	opponentDM, err := s.UserChannelCreate(opponentID)
+	if !l.check(err) {
 		return
 	}
-	opponentDM, _ := s.UserChannelCreate(opponentID)
+
+	// Each player is written to in their own language, general invites stay in the language of the server
+	lang, senderLang := languageOf(r.UserID, options.Guild), languageOf(opponentID, options.Guild)
+	inviteLang := lang
+	if general {
+		inviteLang = languageOf("", options.Guild)
+	}
 	if r.Emoji.Name == "✅" && (general || !hasOtherReactionsBesides("✅", m.Reactions)) {
-		s.ChannelMessageEditEmbed(r.ChannelID, r.MessageID, &discordgo.MessageEmbed{
-			Title:       "Invite Accepted!",
-			Description: "Invite from " + formatUser(sender) + " accepted!",
+		queueEdit(s, r.ChannelID, r.MessageID, &discordgo.MessageEmbed{
+			Title:       i18n.T(inviteLang, "invite.accepted.title"),
+			Description: i18n.T(inviteLang, "invite.accepted", formatUser(sender)),
 			Color:       c_GREEN,
 		})
 
-		// Create a game object
-		game := logic.Game{
-			Selected: 0,
-			Board:    "11111111111100000000222222222222",
-			Turn:     2,
+		game, details, err := newInviteGame(options)
+		if err != nil {
+			l.Error("Could not start the game of the invite", "err", err)
+			l.message(s.ChannelMessageSend(r.ChannelID, errorMessage(i18n.T(inviteLang, "error.bot"), i18n.T(inviteLang, "invite.error.ballot.bot"))))
+			return
 		}
 
+		// Save the game so it can be played on the web too
+		details.Game = saveGame(&game, map[uint8]*discordgo.User{options.Color: sender, otherColor(options.Color): user}, details, options.Time)
+		l = newLog("reaction:invite", details.Game, options.Guild, r.UserID, opponentID)
+		startSpectating(s, options.Guild, details.Game)
+		startReporting(options.Guild, details.Game)
+
 		var reciepientDMID string
 		if !general {
 			reciepientDMID = r.ChannelID
 		} else {
-			reciepientDM, _ := s.UserChannelCreate(r.UserID)
+			reciepientDM, err := s.UserChannelCreate(r.UserID)
+			if !l.check(err) {
+				return
+			}
 			reciepientDMID = reciepientDM.ID
 		}
 
-		gamemsg, err := s.ChannelMessageSendEmbed(reciepientDMID, gameEmbed(s, "select", opponentID, &game, game.Board, false))
-		if err != nil {
+		// If it's the senders turn they get the first move
+		if options.Color == game.Turn {
+			gamemsg, err := s.ChannelMessageSendEmbed(opponentDM.ID, gameEmbed(s, opponentDM.GuildID, "select", opponentID, r.UserID, &game, details, nil, false))
+			if !l.check(err) {
+				return
+			}
+			l.message(s.ChannelMessageSend(reciepientDMID, successMessage(i18n.T(lang, "game.start.title"), i18n.T(lang, "game.start.wait", formatColor(lang, otherColor(options.Color)), formatUser(sender)))))
+			addSelectReactions(s, opponentDM.ID, gamemsg.ID, &game)
+			return
+		}
+
+		gamemsg, err := s.ChannelMessageSendEmbed(reciepientDMID, gameEmbed(s, "", "select", r.UserID, opponentID, &game, details, nil, false))
+		if !l.check(err) {
 			return
 		}
-		s.ChannelMessageSend(opponentDM.ID, successMessage("Game on!", formatUser(user)+" accepted your checkers invite! Wait here for them to make their move."))
+		l.message(s.ChannelMessageSend(opponentDM.ID, successMessage(i18n.T(senderLang, "game.start.title"), i18n.T(senderLang, "game.start.accepted", formatUser(user), formatColor(senderLang, options.Color)))))
 		addSelectReactions(s, reciepientDMID, gamemsg.ID, &game)
 	} else if !general && r.Emoji.Name == "❌" && !hasOtherReactionsBesides("❌", m.Reactions) {
-		s.ChannelMessageEditEmbed(r.ChannelID, r.MessageID, &discordgo.MessageEmbed{
-			Title:       "Invite Declined",
-			Description: "Invite from " + formatUser(sender) + " declined.",
+		queueEdit(s, r.ChannelID, r.MessageID, &discordgo.MessageEmbed{
+			Title:       i18n.T(lang, "invite.declined.title"),
+			Description: i18n.T(lang, "invite.declined", formatUser(sender)),
 			Color:       c_RED,
 		})
-		s.ChannelMessageSend(opponentDM.ID, errorMessage("Invite declined", formatUser(user)+" declined your checkers game invite."))
+		l.message(s.ChannelMessageSend(opponentDM.ID, errorMessage(i18n.T(senderLang, "invite.refused.title"), i18n.T(senderLang, "invite.refused", formatUser(user)))))
 	}
 }
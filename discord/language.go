@@ -0,0 +1,64 @@
+package discord
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/i18n"
+)
+
+// Handlers/Functions for the language the bot talks in
+
+// Gets the language of the messages sent to a user, their own choice comes before the one of the server
+func languageOf(userID string, guildID string) string {
+	if userID != "" {
+		if lang := getUserPrefs(userID).Language; lang != "" {
+			return lang
+		}
+	}
+	if lang := getGuildConfig(guildID).Language; lang != "" {
+		return lang
+	}
+	return i18n.DEFAULT
+}
+
+// Lists the available languages with their codes
+func formatLanguages() string {
+	var names []string
+	for _, l := range i18n.Languages {
+		names = append(names, "`"+l.Code+"` "+l.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Shows or changes the language the bot talks to a user in
+func languageCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+	l := newLog("command:language", "", m.GuildID, m.Author.ID)
+	prefs := getUserPrefs(m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
+
+	if len(args) > 1 {
+		switch code := strings.ToLower(args[1]); {
+		case code == "auto":
+			prefs.Language = ""
+		case i18n.Supported(code):
+			prefs.Language = code
+		default:
+			l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "language.error.title"), i18n.T(lang, "language.error", formatLanguages()))))
+			return
+		}
+
+		if err := store.Put("users", m.Author.ID, prefs); err != nil {
+			l.Error("Could not save the language", "err", err)
+			l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "language.error.save"))))
+			return
+		}
+		lang = languageOf(m.Author.ID, m.GuildID)
+	}
+
+	l.message(s.ChannelMessageSendEmbed(m.ChannelID, &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "language.title", i18n.Name(lang)),
+		Description: i18n.T(lang, "language.description", getGuildConfig(m.GuildID).prefix(), formatLanguages()),
+		Color:       c_BLUE,
+	}))
+}
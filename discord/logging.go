@@ -0,0 +1,62 @@
+package discord
+
+import (
+	"log/slog"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/games"
+)
+
+// Handlers/Functions for logging, every line says which handler, game, users and guild it is about
+
+// Logs what one run of a handler does
+type handlerLog struct {
+	*slog.Logger
+}
+
+// Creates the log of a handler, the game and guild are empty when there is none
+func newLog(handler string, gameID string, guildID string, userIDs ...string) handlerLog {
+	if userIDs == nil {
+		userIDs = []string{}
+	}
+	return handlerLog{slog.Default().With("handler", handler, "game_id", gameID, "guild_id", guildID, "user_ids", userIDs)}
+}
+
+// Logs a Discord request that failed, returns if it succeeded
+func (l handlerLog) check(err error) bool {
+	if err != nil {
+		l.Error("Discord request failed", requestAttrs(err)...)
+	}
+	return err == nil
+}
+
+// Logs a message that could not be sent, fetched or edited, returns the message
+func (l handlerLog) message(m *discordgo.Message, err error) *discordgo.Message {
+	l.check(err)
+	return m
+}
+
+// Gets what to log about an error, with the endpoint and status of errors from the Discord API
+func requestAttrs(err error) []any {
+	attrs := []any{"err", err}
+	if restErr, ok := err.(*discordgo.RESTError); ok {
+		if restErr.Request != nil {
+			attrs = append(attrs, "method", restErr.Request.Method, "path", restErr.Request.URL.Path)
+		}
+		if restErr.Response != nil {
+			attrs = append(attrs, "status", restErr.Response.StatusCode)
+		}
+	}
+	return attrs
+}
+
+// Creates the log of a handler of a game event, with the Discord players of the game
+func eventLog(handler string, g games.Game) handlerLog {
+	var userIDs []string
+	for _, number := range []uint8{c_PLAYER_BLUE, c_PLAYER_RED} {
+		if id := g.Players[number].DiscordID; id != "" {
+			userIDs = append(userIDs, id)
+		}
+	}
+	return newLog(handler, g.ID, "", userIDs...)
+}
@@ -4,29 +4,28 @@ import (
 	"errors"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
 	"github.com/jmsheff/discord-checkers/logic"
 )
 
 // Gets the given move from the reaction
-func getMoveFromReaction(e *discordgo.Emoji, square *logic.Square, game *logic.Game) (logic.Move, error) {
-	var moveTo logic.Move
-	for i, j := range movesSlice {
-		if e.Name == j {
-			if move := square.MoveAtDirection(logic.Directions[i], game, false); move.Possible {
-				moveTo = move
-				break
-			} else {
-				return moveTo, errors.New("Move not possible")
-			}
+func getMoveFromReaction(e *discordgo.Emoji, moves []logic.Move) (logic.Move, error) {
+	for i, marker := range moveMarkers(moves) {
+		if e.Name == marker {
+			return moves[i], nil
 		}
 	}
 
-	return moveTo, nil
+	return logic.Move{}, errors.New("Move not possible")
 }
 
 // Handles all move related reactions
 func moveReactionHandler(s *discordgo.Session, r *discordgo.MessageReactionAdd, m *discordgo.Message, user *discordgo.User, gameString string) {
 	opponentID, game, err := ParseGame(gameString)
+	details := parseDetails(gameString)
+	l := newLog("reaction:move", details.Game, r.GuildID, r.UserID, opponentID)
+	lang := languageOf(r.UserID, "")
 	// Allows there to only be one reaction present at a time to prevent reaction spam
 	if hasOtherReactionsBesides(r.Emoji.Name, m.Reactions) {
 		return
@@ -36,83 +35,69 @@ func moveReactionHandler(s *discordgo.Session, r *discordgo.MessageReactionAdd,
 	if r.Emoji.Name == "❌" {
 		game.Selected = 0
 		if err != nil {
-			s.ChannelMessageEdit(r.ChannelID, r.MessageID, errorMessage("Bot error", "Could not deselect piece"))
+			l.Error("Could not read the board to deselect", "err", err)
+			l.message(s.ChannelMessageEdit(r.ChannelID, r.MessageID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "error.deselect"))))
 		}
 
-		gamemsg, _ := s.ChannelMessageSendEmbed(r.ChannelID, gameEmbed(s, "select", opponentID, &game, game.Board, false))
-		s.ChannelMessageDelete(r.ChannelID, r.MessageID)
+		gamemsg, err := s.ChannelMessageSendEmbed(r.ChannelID, gameEmbed(s, r.GuildID, "select", r.UserID, opponentID, &game, details, nil, false))
+		if !l.check(err) {
+			return
+		}
+		queueDelete(s, r.ChannelID, r.MessageID)
 		addSelectReactions(s, r.ChannelID, gamemsg.ID, &game)
 
 		return
 	}
 	if err != nil {
-		s.ChannelMessageEdit(r.ChannelID, r.MessageID, errorMessage("Error getting game", "This game has most likely expired or doesn't exist."))
+		l.Error("Could not read the board", "err", err)
+		l.message(s.ChannelMessageEdit(r.ChannelID, r.MessageID, errorMessage(i18n.T(lang, "error.game.title"), i18n.T(lang, "error.game"))))
 		return
 	}
 
-	// These both will not have errors unless there is flawed logic in selection
+	// These will not have errors unless there is flawed logic in selection
 	square, _ := logic.SquareAtIndex(game.Selected, &game)
-	move, _ := getMoveFromReaction(&r.Emoji, &square, &game)
-
-	logic.MovePiece(square, move, &game.Board)
-	updatedSquare, _ := logic.SquareAtIndex(move.S.Index, &game)
-
-	if (move.Jumped != logic.Square{}) { // If move was a jump
-		// Check for win
-		if p1score, p2score := logic.GetScore(&game); p1score == 12 || p2score == 12 {
-			// err := cache.DeleteGame(identifier)
-			if err != nil {
-				s.ChannelMessageSend(r.ChannelID, errorMessage("Bot error", "Could not delete game."))
-				return
-			}
-
-			loser, _ := s.User(opponentID)
-			loserDM, _ := s.UserChannelCreate(opponentID)
-			s.ChannelMessageSendEmbed(r.ChannelID, &discordgo.MessageEmbed{
-				Title:       "🎉 YOU WIN!!! 🏆",
-				Description: "Congratulations! You won the game against " + formatUser(loser),
-				Color:       c_GREEN,
-			})
-
-			s.ChannelMessageSendEmbed(loserDM.ID, &discordgo.MessageEmbed{
-				Title:       "❌ You lost. ❌",
-				Description: "You lost the game against " + formatUser(user) + ". Better luck next time!",
-				Color:       c_RED,
-			})
-
-			s.ChannelMessageDelete(r.ChannelID, r.MessageID)
-			return
-		}
-
-		// Check for double jump
-		if doubleJumps, err := updatedSquare.GetAvailableMoves(&game, true); err == nil {
-			// Selects the piece at the updated location and provides only double jump moves
-			selectPiece(s, r.ChannelID, r.MessageID, opponentID, &game, &move.S, &doubleJumps, true)
-			return
-		}
-	}
-
-	// Confirm with the current player that their move went through
-	s.ChannelMessageSend(r.ChannelID, successMessage("Move sent!", "Wait here for them to make their move."))
-	s.ChannelMessageEditEmbed(r.ChannelID, r.MessageID, gameEmbed(s, "", opponentID, &game, game.Board, true)) // Keep a record of the move
-
-	// If no double jump swap turn
-	err = logic.SwapTurn(&game)
+	moves, _ := square.GetAvailableMoves(&game)
+	move, err := getMoveFromReaction(&r.Emoji, moves)
 	if err != nil {
-		s.ChannelMessageEdit(r.ChannelID, r.MessageID, errorMessage("Bot error", "Could not swap turn"))
 		return
 	}
 
-	// Send game to opponent for their move
-	opponentDM, err := s.UserChannelCreate(opponentID)
-	if err != nil {
-		s.ChannelMessageEdit(r.ChannelID, r.MessageID, errorMessage("Bot error", "Could not open DM with opponent"))
+	// Puzzles are checked against their solution instead of being played through the game service
+	if details.Puzzle != 0 {
+		puzzleStepHandler(s, l, r, user, opponentID, game, details, square, move)
 		return
 	}
-	opponentMsg, err := s.ChannelMessageSendEmbed(opponentDM.ID, gameEmbed(s, "select", r.UserID, &game, game.Board, false))
-	if err != nil {
-		s.ChannelMessageEdit(r.ChannelID, r.MessageID, errorMessage("Bot error", "Could not send opponent message"))
-		return
+
+	// The game service sends the boards and messages that follow the move
+	origin := reactionOrigin{ChannelID: r.ChannelID, MessageID: r.MessageID, OpponentID: opponentID, Details: details}
+	_, err = gameService.Step(gameOf(&game, details, r.UserID, opponentID), square, move, games.SOURCE_DISCORD, origin)
+	if err == games.ErrOver {
+		l.message(s.ChannelMessageEdit(r.ChannelID, r.MessageID, errorMessage(i18n.T(lang, "game.over.title"), i18n.T(lang, "game.over"))))
+	} else if err == games.ErrStale {
+		sendStale(s, l, lang, r.ChannelID, r.MessageID)
+	} else if err != nil {
+		l.Error("Could not make the move", "err", err)
+		l.message(s.ChannelMessageEdit(r.ChannelID, r.MessageID, errorMessage(i18n.T(lang, "error.bot"), formatError(lang, err))))
+	}
+}
+
+// Lets both players know who won the game in their language, an empty channel ID skips a player
+func sendGameOver(s *discordgo.Session, l handlerLog, winnerChannelID string, loserChannelID string, winner *discordgo.User, loser *discordgo.User, reason func(lang string) string) {
+	if winnerChannelID != "" {
+		lang := languageOf(winner.ID, "")
+		l.message(s.ChannelMessageSendEmbed(winnerChannelID, &discordgo.MessageEmbed{
+			Title:       i18n.T(lang, "game.won.title"),
+			Description: i18n.T(lang, "game.won", formatUser(loser), reason(lang)),
+			Color:       c_GREEN,
+		}))
+	}
+
+	if loserChannelID != "" {
+		lang := languageOf(loser.ID, "")
+		l.message(s.ChannelMessageSendEmbed(loserChannelID, &discordgo.MessageEmbed{
+			Title:       i18n.T(lang, "game.lost.title"),
+			Description: i18n.T(lang, "game.lost", formatUser(winner), reason(lang)),
+			Color:       c_RED,
+		}))
 	}
-	addSelectReactions(s, opponentMsg.ChannelID, opponentMsg.ID, &game)
 }
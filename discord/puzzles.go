@@ -0,0 +1,270 @@
+package discord
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/puzzles"
+	"github.com/jmsheff/discord-checkers/storage"
+)
+
+// Handlers/Functions for everything puzzle related
+
+// Data saved by the bot, kept in memory until UseStore is called
+var store, _ = storage.Open("")
+
+// Sets where the data of the bot is saved
+func UseStore(s *storage.Store) {
+	store = s
+}
+
+// A players puzzle rating and the puzzles they have tried
+type puzzleRecord struct {
+	Rating int   // The players puzzle rating
+	Solved int   // Number of puzzles solved on the first try
+	Failed int   // Number of puzzles failed on the first try
+	Played []int // The numbers of every puzzle tried
+}
+
+// Gets the puzzle record of a user
+func getPuzzleRecord(userID string) puzzleRecord {
+	record := puzzleRecord{Rating: puzzles.START_RATING}
+	store.Get("puzzles", userID, &record)
+	return record
+}
+
+// Checks if a puzzle has been tried before
+func (record puzzleRecord) hasPlayed(number int) bool {
+	for _, n := range record.Played {
+		if n == number {
+			return true
+		}
+	}
+	return false
+}
+
+// Describes a puzzle for the player to move
+func formatPuzzle(lang string, p puzzles.Puzzle, game *logic.Game) string {
+	return i18n.T(lang, "puzzle.description", p.Theme, formatColor(lang, game.Turn), p.Rating)
+}
+
+// Handles all puzzle commands
+func puzzleCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate, cmd []string) {
+	l := newLog("command:puzzle", "", m.GuildID, m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
+	prefix := getGuildConfig(m.GuildID).prefix()
+	record := getPuzzleRecord(m.Author.ID)
+	number := 0
+	if len(cmd) > 1 {
+		switch strings.ToLower(cmd[1]) {
+		case "rating":
+			l.message(s.ChannelMessageSend(m.ChannelID, successMessage(i18n.T(lang, "puzzle.rating.title"), i18n.T(lang, "puzzle.rating", formatUser(m.Author), record.Rating, record.Solved, record.Failed))))
+			return
+		case "daily":
+			number = puzzles.Daily(time.Now())
+		default:
+			n, err := strconv.Atoi(strings.TrimPrefix(cmd[1], "#"))
+			if err != nil {
+				l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "puzzle.error.title"), i18n.T(lang, "game.help.topic", prefix, "puzzles"))))
+				return
+			}
+			number = n
+		}
+	} else if number = puzzles.Pick(record.Rating, record.Played); number == 0 {
+		l.message(s.ChannelMessageSend(m.ChannelID, successMessage(i18n.T(lang, "puzzle.done.title"), i18n.T(lang, "puzzle.done", prefix))))
+		return
+	}
+
+	p, err := puzzles.Get(number)
+	if err != nil {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "puzzle.error.title"), i18n.T(lang, "puzzle.error", number))))
+		return
+	}
+	game, err := p.Game()
+	if err != nil {
+		l.Error("Could not set up the puzzle", "puzzle", number, "err", err)
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "puzzle.error.setup"))))
+		return
+	}
+
+	dm, err := s.UserChannelCreate(m.Author.ID)
+	if !l.check(err) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "error.dm"))))
+		return
+	}
+
+	// The bot is the opponent so the normal selection and movement can be used
+	gamemsg, err := s.ChannelMessageSendEmbed(dm.ID, gameEmbed(s, dm.GuildID, "select", m.Author.ID, s.State.User.ID, &game, gameDetails{Puzzle: number}, nil, false))
+	if !l.check(err) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "puzzle.error.send"))))
+		return
+	}
+	addSelectReactions(s, dm.ID, gamemsg.ID, &game)
+
+	if dm.ID != m.ChannelID {
+		l.message(s.ChannelMessageSend(m.ChannelID, successMessage(i18n.T(lang, "puzzle.sent.title"), i18n.T(lang, "puzzle.sent", number))))
+	}
+}
+
+// Makes a step of a move in a puzzle, the move is checked once it is over
+func puzzleStepHandler(s *discordgo.Session, l handlerLog, r *discordgo.MessageReactionAdd, user *discordgo.User, opponentID string, game logic.Game, details gameDetails, square logic.Square, move logic.Move) {
+	details.Moves = games.RecordStep(details.Moves, &game, square, move)
+
+	// Selects the piece at the updated location and provides only the jumps it can continue with
+	if multiJump := logic.MovePiece(square, move, &game); multiJump {
+		updatedSquare, _ := logic.SquareAtIndex(move.S.Index, &game)
+		jumps, _ := updatedSquare.GetAvailableMoves(&game)
+		selectPiece(s, l, r.GuildID, r.ChannelID, r.MessageID, r.UserID, opponentID, &game, details, &updatedSquare, &jumps, true)
+		return
+	}
+
+	puzzleMoveHandler(s, l, r, user, game, details)
+}
+
+// Checks the move made in a puzzle and plays the defence, the game is the position after the move
+func puzzleMoveHandler(s *discordgo.Session, l handlerLog, r *discordgo.MessageReactionAdd, user *discordgo.User, game logic.Game, details gameDetails) {
+	p, err := puzzles.Get(details.Puzzle)
+	if err != nil {
+		l.Error("Could not get the puzzle", "puzzle", details.Puzzle, "err", err)
+		return
+	}
+
+	lang := languageOf(r.UserID, "")
+	solution := p.Moves()
+	ply := len(details.Moves) - 1
+	if ply >= len(solution) || details.Moves[ply] != solution[ply] {
+		finishPuzzle(s, l, r, user, &game, details, p, false)
+		return
+	} else if ply == len(solution)-1 {
+		finishPuzzle(s, l, r, user, &game, details, p, true)
+		return
+	}
+
+	// The bot defends with the next move of the solution
+	logic.SwapTurn(&game)
+	defence, err := logic.ParseSequence(solution[ply+1], &game)
+	if err != nil {
+		l.Error("Could not play the defence", "puzzle", details.Puzzle, "err", err)
+		l.message(s.ChannelMessageSend(r.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "puzzle.error.defence"))))
+		return
+	}
+	logic.ApplySequence(defence, &game)
+	logic.SwapTurn(&game)
+	details.Moves = append(details.Moves, solution[ply+1])
+
+	queueDelete(s, r.ChannelID, r.MessageID)
+	l.message(s.ChannelMessageSend(r.ChannelID, successMessage(i18n.T(lang, "puzzle.correct.title"), i18n.T(lang, "puzzle.correct", solution[ply+1]))))
+	gamemsg, err := s.ChannelMessageSendEmbed(r.ChannelID, gameEmbed(s, r.GuildID, "select", r.UserID, s.State.User.ID, &game, details, nil, false))
+	if !l.check(err) {
+		return
+	}
+	addSelectReactions(s, r.ChannelID, gamemsg.ID, &game)
+}
+
+// Ends a puzzle and updates the rating of the player if it is their first try
+func finishPuzzle(s *discordgo.Session, l handlerLog, r *discordgo.MessageReactionAdd, user *discordgo.User, game *logic.Game, details gameDetails, p puzzles.Puzzle, solved bool) {
+	queueEdit(s, r.ChannelID, r.MessageID, gameEmbed(s, r.GuildID, "", r.UserID, s.State.User.ID, game, details, nil, true)) // Keep a record of the move
+
+	lang := languageOf(user.ID, "")
+	record := getPuzzleRecord(user.ID)
+	rating := ""
+	if !record.hasPlayed(details.Puzzle) {
+		old := record.Rating
+		record.Rating = puzzles.Update(record.Rating, p, solved)
+		record.Played = append(record.Played, details.Puzzle)
+		if solved {
+			record.Solved++
+		} else {
+			record.Failed++
+		}
+		if err := store.Put("puzzles", user.ID, record); err != nil {
+			l.Error("Could not save the puzzle rating", "err", err)
+		}
+
+		change := strconv.Itoa(record.Rating - old)
+		if record.Rating >= old {
+			change = "+" + change
+		}
+		rating = "\n" + i18n.T(lang, "puzzle.rating.change", record.Rating, change)
+	}
+
+	// Puzzles are played in DMs, so the prefix is the default one
+	next := "\n" + i18n.T(lang, "puzzle.next", getGuildConfig("").prefix())
+	if solved {
+		l.message(s.ChannelMessageSend(r.ChannelID, successMessage(i18n.T(lang, "puzzle.solved.title"), i18n.T(lang, "puzzle.solved", details.Puzzle)+rating+next)))
+		return
+	}
+	l.message(s.ChannelMessageSend(r.ChannelID, errorMessage(i18n.T(lang, "puzzle.failed.title"), i18n.T(lang, "puzzle.failed", p.Solution)+rating+next)))
+}
+
+// Posts the puzzle of the day to a channel every day at a time after midnight UTC
+func StartDailyPuzzles(s *discordgo.Session, channelID string, at time.Duration) {
+	go func() {
+		for {
+			// Post right away if today's puzzle was missed while the bot was offline
+			now := time.Now().UTC()
+			today := now.Truncate(24 * time.Hour)
+			var posted string
+			store.Get("daily", channelID, &posted)
+			if now.Sub(today) >= at && posted != today.Format("2006-01-02") {
+				sendDailyPuzzle(s, channelID, today)
+				if err := store.Put("daily", channelID, today.Format("2006-01-02")); err != nil {
+					newLog("daily_puzzle", "", "").Error("Could not save the day of the last puzzle", "channel_id", channelID, "err", err)
+				}
+			}
+
+			next := today.Add(at)
+			if !now.Before(next) {
+				next = next.Add(24 * time.Hour)
+			}
+			time.Sleep(next.Sub(now))
+		}
+	}()
+}
+
+// Posts the puzzle of a day to a channel
+func sendDailyPuzzle(s *discordgo.Session, channelID string, day time.Time) {
+	// The board is posted in the theme of the server
+	var guildID string
+	c, err := s.Channel(channelID)
+	if err == nil {
+		guildID = c.GuildID
+	}
+	l := newLog("daily_puzzle", "", guildID)
+	l.check(err)
+
+	number := puzzles.Daily(day)
+	p, err := puzzles.Get(number)
+	if err != nil {
+		l.Error("Could not get the daily puzzle", "puzzle", number, "err", err)
+		return
+	}
+	game, err := p.Game()
+	if err != nil {
+		l.Error("Could not set up the daily puzzle", "puzzle", number, "err", err)
+		return
+	}
+
+	// The puzzle is posted in the language of the server, the date is written the same way in every language
+	lang := languageOf("", guildID)
+	l.message(s.ChannelMessageSendEmbed(channelID, &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "puzzle.daily.title", day.Format("2006-01-02")),
+		Description: formatPuzzle(lang, p, &game),
+		Color:       c_GOLD,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:  i18n.T(lang, "game.board"),
+				Value: formatBoard(&game.Board, game.Rules(), nil, guildTheme(guildID)),
+			},
+			{
+				Name:  i18n.T(lang, "puzzle.daily.solve.title"),
+				Value: i18n.T(lang, "puzzle.daily.solve", getGuildConfig(guildID).prefix()),
+			},
+		},
+	}))
+}
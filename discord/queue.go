@@ -0,0 +1,223 @@
+package discord
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Handlers/Functions for the outbound queues, which send reactions, edits and deletes to Discord one channel at a time
+
+// Action kinds
+const (
+	c_ACTION_REACT  = iota // Adds reactions to a message
+	c_ACTION_EDIT          // Replaces the embed of a message
+	c_ACTION_DELETE        // Deletes a message
+)
+
+// Number of times a request is retried after a rate limit or a server error
+const c_QUEUE_RETRIES = 3
+
+// Wait before the first retry when Discord doesn't say how long to wait, doubled on each retry
+const c_QUEUE_BACKOFF = 500 * time.Millisecond
+
+// An operation waiting to be sent to Discord
+type action struct {
+	Kind      int                     // What the action does, one of the action kinds
+	MessageID string                  // ID of the message the action is on
+	Emojis    []string                // Reactions to add, in order
+	Embed     *discordgo.MessageEmbed // Embed to replace the message with
+	Queued    time.Time               // When the action was first queued
+}
+
+// The actions waiting to be sent in a channel, in the order they were queued
+type channelQueue struct {
+	Pending []*action // Actions not sent yet
+}
+
+// Counts what went through the queues, for monitoring
+type QueueStats struct {
+	Depth      int           // Actions waiting in every channel
+	Done       int           // Actions sent
+	Retries    int           // Requests retried after a rate limit or a server error
+	Failed     int           // Requests given up on
+	Latency    time.Duration // Average time from queueing an action to it being sent
+	MaxLatency time.Duration // Longest time from queueing an action to it being sent
+}
+
+// Queues by channel ID with the stats of every queue, a queue only exists while it has actions to send
+var queues = struct {
+	sync.Mutex
+	channels map[string]*channelQueue
+	stats    QueueStats
+	total    time.Duration
+}{channels: make(map[string]*channelQueue)}
+
+// Gets the stats of the queues
+func QueueMetrics() QueueStats {
+	queues.Lock()
+	defer queues.Unlock()
+	stats := queues.stats
+	if stats.Done != 0 {
+		stats.Latency = queues.total / time.Duration(stats.Done)
+	}
+	return stats
+}
+
+// Queues reactions to add to a message, they are added to reactions already waiting for the same message
+func queueReactions(s *discordgo.Session, channelID string, messageID string, emojis ...string) {
+	enqueue(s, channelID, &action{Kind: c_ACTION_REACT, MessageID: messageID, Emojis: emojis})
+}
+
+// Queues replacing the embed of a message, an edit still waiting for the same message is replaced
+func queueEdit(s *discordgo.Session, channelID string, messageID string, embed *discordgo.MessageEmbed) {
+	enqueue(s, channelID, &action{Kind: c_ACTION_EDIT, MessageID: messageID, Embed: embed})
+}
+
+// Queues deleting a message, anything still waiting for the message is dropped
+func queueDelete(s *discordgo.Session, channelID string, messageID string) {
+	enqueue(s, channelID, &action{Kind: c_ACTION_DELETE, MessageID: messageID})
+}
+
+// Adds an action to the queue of its channel, batching it with the actions waiting for the same message, and starts sending the queue
+func enqueue(s *discordgo.Session, channelID string, a *action) {
+	queues.Lock()
+	defer queues.Unlock()
+
+	a.Queued = time.Now()
+	q, running := queues.channels[channelID]
+	if !running {
+		q = &channelQueue{}
+		queues.channels[channelID] = q
+		go sendQueue(s, channelID, q)
+	}
+
+	switch a.Kind {
+	case c_ACTION_REACT:
+		// Every reaction waiting for a message is added in one go
+		for _, p := range q.Pending {
+			if p.Kind == c_ACTION_REACT && p.MessageID == a.MessageID {
+				p.Emojis = append(p.Emojis, a.Emojis...)
+				return
+			}
+		}
+	case c_ACTION_EDIT:
+		// Only the latest version of a message is worth sending
+		for _, p := range q.Pending {
+			if p.Kind == c_ACTION_EDIT && p.MessageID == a.MessageID {
+				p.Embed = a.Embed
+				return
+			}
+		}
+	case c_ACTION_DELETE:
+		// Nothing else needs to be sent for a message that is deleted
+		kept := q.Pending[:0]
+		for _, p := range q.Pending {
+			if p.MessageID != a.MessageID {
+				kept = append(kept, p)
+			}
+		}
+		queues.stats.Depth -= len(q.Pending) - len(kept)
+		q.Pending = kept
+	}
+	q.Pending = append(q.Pending, a)
+	queues.stats.Depth++
+}
+
+// Sends the actions of a channel one by one until none are left
+func sendQueue(s *discordgo.Session, channelID string, q *channelQueue) {
+	for {
+		queues.Lock()
+		if len(q.Pending) == 0 {
+			delete(queues.channels, channelID)
+			queues.Unlock()
+			return
+		}
+		a := q.Pending[0]
+		q.Pending = q.Pending[1:]
+		queues.stats.Depth--
+		queues.Unlock()
+
+		sendAction(s, channelID, a)
+
+		latency := time.Since(a.Queued)
+		queues.Lock()
+		queues.stats.Done++
+		queues.total += latency
+		if latency > queues.stats.MaxLatency {
+			queues.stats.MaxLatency = latency
+		}
+		queues.Unlock()
+	}
+}
+
+// Sends an action to Discord, the requests that fail for good are logged with the message they were on
+func sendAction(s *discordgo.Session, channelID string, a *action) {
+	l := handlerLog{newLog("queue", "", "").With("channel_id", channelID, "message_id", a.MessageID)}
+	switch a.Kind {
+	case c_ACTION_REACT:
+		for _, e := range a.Emojis {
+			// The other reactions can't be added either once the message is gone
+			err := retry(func() error { return s.MessageReactionAdd(channelID, a.MessageID, e) })
+			if l.check(err); isNotFound(err) {
+				return
+			}
+		}
+	case c_ACTION_EDIT:
+		l.check(retry(func() error {
+			_, err := s.ChannelMessageEditEmbed(channelID, a.MessageID, a.Embed)
+			return err
+		}))
+	case c_ACTION_DELETE:
+		l.check(retry(func() error { return s.ChannelMessageDelete(channelID, a.MessageID) }))
+	}
+}
+
+// Makes a request until it succeeds, fails for good or runs out of retries, waiting as long as Discord asks between tries
+func retry(request func() error) error {
+	err := request()
+	for try := 0; err != nil && isRetryable(err) && try < c_QUEUE_RETRIES; try++ {
+		queues.Lock()
+		queues.stats.Retries++
+		queues.Unlock()
+
+		time.Sleep(retryAfter(err, try))
+		err = request()
+	}
+	if err != nil {
+		queues.Lock()
+		queues.stats.Failed++
+		queues.Unlock()
+	}
+	return err
+}
+
+// Checks if a request that failed can succeed later, discordgo already waits out most rate limits itself
+func isRetryable(err error) bool {
+	restErr, ok := err.(*discordgo.RESTError)
+	if !ok || restErr.Response == nil {
+		return true // The request didn't reach Discord
+	}
+	return restErr.Response.StatusCode == http.StatusTooManyRequests || restErr.Response.StatusCode >= http.StatusInternalServerError
+}
+
+// Checks if a request failed because what it was on doesn't exist anymore
+func isNotFound(err error) bool {
+	restErr, ok := err.(*discordgo.RESTError)
+	return ok && restErr.Response != nil && restErr.Response.StatusCode == http.StatusNotFound
+}
+
+// Gets how long to wait before retrying a request, from the rate limit headers of the response if there are any
+func retryAfter(err error, try int) time.Duration {
+	if restErr, ok := err.(*discordgo.RESTError); ok && restErr.Response != nil {
+		for _, header := range []string{"Retry-After", "X-RateLimit-Reset-After"} {
+			if seconds, err := strconv.ParseFloat(restErr.Response.Header.Get(header), 64); err == nil && seconds > 0 {
+				return time.Duration(seconds * float64(time.Second))
+			}
+		}
+	}
+	return c_QUEUE_BACKOFF << uint(try)
+}
@@ -0,0 +1,255 @@
+package discord
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/engine"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/pdn"
+)
+
+// Handlers/Functions for the report posted after a game
+
+// Each position of a finished game gets less time than a hint since there are a lot of them
+const c_REVIEW_TIME = 500 * time.Millisecond
+
+// The longest a whole game is reviewed for, long games give each position less time
+const c_REVIEW_BUDGET = 30 * time.Second
+
+// The rows of the evaluation graph above and below the line, each row is worth one man
+const c_GRAPH_HEIGHT = 4
+
+// The most columns of the evaluation graph, longer games are squeezed to fit
+const c_GRAPH_WIDTH = 50
+
+// Marks for the move of each judgement in PDN
+var strengthSlice []string = []string{"", "?!", "?", "??"}
+
+// Games are reviewed one at a time so games ending together don't take over the engine
+var reviews = make(chan struct{}, 1)
+
+// Names of each judgement
+var judgementSlice []string = []string{"report.good", "report.inaccuracy", "report.mistake", "report.blunder"}
+
+// A channel the report of a game is posted in, the report is written in the language of the player or of the server
+type reportTarget struct {
+	ChannelID string // The channel the report is posted in
+	UserID    string // The player the channel is a DM with, empty for the report channel of a server
+	GuildID   string // The server the report channel is in, empty for DMs
+}
+
+// Gets the language a report is written in
+func (t reportTarget) language() string {
+	return languageOf(t.UserID, t.GuildID)
+}
+
+// Remembers to post the report of a game in the report channel of the server it was started in, if the server has one
+func startReporting(guildID string, gameID string) {
+	channelID := getGuildConfig(guildID).ReportChannel
+	if channelID == "" || gameID == "" {
+		return
+	}
+	if err := store.Put("reports", gameID, reportTarget{ChannelID: channelID, GuildID: guildID}); err != nil {
+		newLog("report", gameID, guildID).Error("Could not save the report channel", "err", err)
+	}
+}
+
+// Gets the report channel of a game once it is over, returns false if its server has none
+func reportChannel(gameID string) (reportTarget, bool) {
+	var target reportTarget
+	if gameID == "" || !store.Get("reports", gameID, &target) {
+		return reportTarget{}, false
+	}
+	if err := store.Delete("reports", gameID); err != nil {
+		newLog("report", gameID, target.GuildID).Error("Could not remove the report channel", "err", err)
+	}
+	return target, true
+}
+
+// Replays the moves of a game from the position it started from, games saved without one start from the start of the variant
+func replayMoves(r logic.Rules, fen string, moves []string) (logic.Game, []logic.Sequence, error) {
+	start := logic.NewGame(r)
+	if fen != "" {
+		var err error
+		if start, err = logic.ParseFEN(fen, r); err != nil {
+			return logic.Game{}, nil, err
+		}
+	}
+	game := start
+	var sequences []logic.Sequence
+	for _, notation := range moves {
+		seq, err := logic.ParseSequence(notation, &game)
+		if err != nil {
+			return logic.Game{}, nil, errors.New("Could not replay " + notation)
+		}
+		sequences = append(sequences, seq)
+		logic.ApplySequence(seq, &game)
+		logic.SwapTurn(&game)
+	}
+
+	return start, sequences, nil
+}
+
+// Gets the score of a move for a player
+func scoreFor(a engine.Annotation, player uint8) int {
+	if a.Player == player {
+		return a.After
+	}
+	return -a.After
+}
+
+// Draws the score after each move as a bar graph, scores above the line are good for the player
+func formatGraph(annotations []engine.Annotation, player uint8) string {
+	columns := len(annotations)
+	if columns > c_GRAPH_WIDTH {
+		columns = c_GRAPH_WIDTH
+	}
+
+	// Squeeze long games by only showing the last move of each group of moves
+	scores := make([]int, columns)
+	for c := range scores {
+		scores[c] = scoreFor(annotations[(c+1)*len(annotations)/columns-1], player)
+	}
+
+	var rows []string
+	for level := c_GRAPH_HEIGHT; level >= -c_GRAPH_HEIGHT; level-- {
+		row := strconv.Itoa(level) + " │"
+		if level > 0 {
+			row = "+" + row
+		} else if level == 0 {
+			row = " 0 ┼"
+		}
+
+		for _, score := range scores {
+			switch {
+			case level == 0:
+				row += "─"
+			case level > 0 && score >= level*engine.MAN-engine.MAN/2:
+				row += "█"
+			case level < 0 && score <= level*engine.MAN+engine.MAN/2:
+				row += "█"
+			default:
+				row += " "
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// Describes a move that wasn't the best, from the point of view of the player who made it
+func formatJudgement(lang string, a engine.Annotation, game *logic.Game) string {
+	return i18n.T(lang, "report.judgement", i18n.T(lang, judgementSlice[a.Judgement]), logic.FormatSequence(a.Best, game), formatScore(lang, a.Before), formatScore(lang, a.After))
+}
+
+// Writes the number of a move, moves of the player who moved second get an ellipsis
+func moveNumber(ply int) string {
+	if ply%2 == 0 {
+		return strconv.Itoa(ply/2+1) + "."
+	}
+	return strconv.Itoa(ply/2+1) + "..."
+}
+
+// Reviews a finished game from its start position and posts the report with an annotated PDN to the channels.
+// It takes up to c_REVIEW_BUDGET after waiting for the reviews before it, so it has to run outside of the handler
+func sendReport(s *discordgo.Session, l handlerLog, targets []reportTarget, players map[uint8]*discordgo.User, r logic.Rules, fen string, details gameDetails, winner uint8) {
+	start, moves, err := replayMoves(r, fen, details.Moves)
+	if err != nil {
+		l.Error("Could not replay the game to review it", "err", err)
+		return
+	}
+	if len(moves) == 0 {
+		return
+	}
+
+	for _, t := range targets {
+		l.message(s.ChannelMessageSend(t.ChannelID, i18n.T(t.language(), "report.analyzing")))
+	}
+
+	// Every position including the last one is searched
+	per := c_REVIEW_BUDGET / time.Duration(len(moves)+1)
+	if per > c_REVIEW_TIME {
+		per = c_REVIEW_TIME
+	}
+	reviews <- struct{}{}
+	e := engine.New(engine.Config{Depth: engine.DEFAULT.Depth, Time: per, Tablebase: tablebases})
+	annotations, err := e.Review(start, moves)
+	<-reviews
+	if err != nil {
+		l.Error("Could not review the game", "err", err)
+		for _, t := range targets {
+			lang := t.language()
+			l.message(s.ChannelMessageSend(t.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "report.error"))))
+		}
+		return
+	}
+
+	for _, t := range targets {
+		l.message(s.ChannelMessageSendComplex(t.ChannelID, formatReport(t.language(), annotations, start, players, r, details, winner)))
+	}
+}
+
+// Writes the report of a reviewed game in a language, with the PDN of the game annotated in the same language
+func formatReport(lang string, annotations []engine.Annotation, start logic.Game, players map[uint8]*discordgo.User, r logic.Rules, details gameDetails, winner uint8) *discordgo.MessageSend {
+	// Annotate the PDN and count the bad moves of each player
+	white := logic.White(r)
+	game := pdn.New(r, formatUser(players[white]), formatUser(players[3-white]), details.Moves, pdn.Result(winner, r))
+	counts := map[uint8][]int{1: make([]int, len(judgementSlice)), 2: make([]int, len(judgementSlice))}
+	var moments []string
+	position := start
+	for i, a := range annotations {
+		counts[a.Player][a.Judgement]++
+		if a.Judgement != engine.GOOD {
+			game.Moves[i].Strength = strengthSlice[a.Judgement]
+			game.Moves[i].Comment = formatJudgement(lang, a, &position)
+			moments = append(moments, moveNumber(i)+" "+formatColor(lang, a.Player)+" "+details.Moves[i]+strengthSlice[a.Judgement]+" "+game.Moves[i].Comment)
+		}
+		logic.ApplySequence(a.Move, &position)
+		logic.SwapTurn(&position)
+	}
+
+	var summary []string
+	for _, player := range []uint8{white, 3 - white} {
+		c := counts[player]
+		summary = append(summary, i18n.T(lang, "report.summary", formatColor(lang, player), formatUser(players[player]),
+			i18n.N(lang, "report.blunders", c[engine.BLUNDER]), i18n.N(lang, "report.mistakes", c[engine.MISTAKE]), i18n.N(lang, "report.inaccuracies", c[engine.INACCURACY])))
+	}
+
+	// Fields can only be so long
+	if len(moments) == 0 {
+		moments = []string{i18n.T(lang, "report.moments.none")}
+	}
+	list := ""
+	for i, m := range moments {
+		if len(list)+len(m)+60 > c_FIELD_LIMIT {
+			list += i18n.N(lang, "report.moments.more", len(moments)-i)
+			break
+		}
+		list += m + "\n"
+	}
+
+	return &discordgo.MessageSend{
+		Embed: &discordgo.MessageEmbed{
+			Title:       i18n.T(lang, "report.title", variantTitle(lang, r)),
+			Description: strings.Join(summary, "\n"),
+			Color:       c_PURPLE,
+			Fields: []*discordgo.MessageEmbedField{
+				{
+					Name:  i18n.T(lang, "report.evaluation.title"),
+					Value: "```\n" + formatGraph(annotations, white) + "\n```" + i18n.T(lang, "report.evaluation", formatColor(lang, white)),
+				},
+				{
+					Name:  i18n.T(lang, "report.moments.title"),
+					Value: list,
+				},
+			},
+		},
+		Files: []*discordgo.File{{Name: "game.pdn", ContentType: "text/plain", Reader: strings.NewReader(game.String())}},
+	}
+}
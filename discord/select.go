@@ -5,26 +5,29 @@ import (
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/i18n"
 	"github.com/jmsheff/discord-checkers/logic"
 )
 
 // Adds the reactions to give the user the ability to select a piece
 func addSelectReactions(s *discordgo.Session, c string, m string, g *logic.Game) {
+	width := int(logic.Width(g.Rules()))
+	var reactions []string
+
 	// Adds all the Y coordinate selections
-	for i, e := range ySlice {
+	for i, e := range ySlice[:g.Rules().Size()] {
 		// Gives only the rows that have a piece in them
-		if strings.ContainsAny(g.Board[i*4:i*4+4], strconv.FormatUint(uint64(g.Turn), 10)+strconv.FormatUint(uint64(g.Turn+2), 10)) {
-			s.MessageReactionAdd(c, m, e)
+		if strings.ContainsAny(g.Board[i*width:i*width+width], strconv.FormatUint(uint64(g.Turn), 10)+strconv.FormatUint(uint64(g.Turn+2), 10)) {
+			reactions = append(reactions, e)
 		}
 	}
 
 	// Adds all the x coordinate selections
-	for _, e := range xSlice {
-		// Because of rate limits around reactions, it's not suitable to dynamically add x reactions like we do with y. Therefore we add them all
-		s.MessageReactionAdd(c, m, e)
-	}
+	// Because of rate limits around reactions, it's not suitable to dynamically add x reactions like we do with y. Therefore we add them all
+	reactions = append(reactions, xSlice[:width]...)
 
-	s.MessageReactionAdd(c, m, "✅")
+	// The reactions are added by the queue of the channel so they don't hold up the handler
+	queueReactions(s, c, m, append(reactions, "✅")...)
 }
 
 // Gets an Y coordinate from a selection emoji
@@ -47,45 +50,42 @@ func getXFromReaction(e *discordgo.Emoji) uint8 {
 	return 0
 }
 
-// Selects a piece and shows the moves on the board
-func selectPiece(s *discordgo.Session, c string, m string, opponentID string, game *logic.Game, square *logic.Square, moves *[]logic.Move, jumpsOnly bool) {
-	// Makes a board with moves and a slice of reactions to put on the message
-	board := []rune(game.Board)
-	var reactions []string
+// Selects a piece and shows the moves on the board, the guild ID is the server of the channel the board is in
+func selectPiece(s *discordgo.Session, l handlerLog, guildID string, c string, m string, userID string, opponentID string, game *logic.Game, details gameDetails, square *logic.Square, moves *[]logic.Move, jumpsOnly bool) {
+	// Marks the moves on the board and gets the reactions to put on the message
+	reactions := moveMarkers(*moves)
+	markers := make(map[uint8]string)
 	for i, move := range *moves {
-		if move.Possible {
-			board[move.S.Index] = directionSlice[i]
-			reactions = append(reactions, movesSlice[i])
-		}
+		markers[move.S.Index] = reactions[i]
 	}
 
 	// Select the piece
 	game.Selected = square.Index
 
 	// Send the board with the moves on it
-	gamemsg, err := s.ChannelMessageSendEmbed(c, gameEmbed(s, "move", opponentID, game, string(board), false))
-	if err != nil {
+	gamemsg, err := s.ChannelMessageSendEmbed(c, gameEmbed(s, guildID, "move", userID, opponentID, game, details, markers, false))
+	if !l.check(err) {
 		return
 	}
 
 	// Add the move reactions
 	if !jumpsOnly { // Don't allow cancelling on double jumps
-		s.MessageReactionAdd(gamemsg.ChannelID, gamemsg.ID, "❌")
-	}
-	for _, e := range reactions {
-		s.MessageReactionAdd(gamemsg.ChannelID, gamemsg.ID, e)
+		reactions = append([]string{"❌"}, reactions...)
 	}
+	queueReactions(s, gamemsg.ChannelID, gamemsg.ID, reactions...)
 
-	s.ChannelMessageDelete(c, m) // The reason we delete instead of edit is to get around not being able to clear reactions
+	queueDelete(s, c, m) // The reason we delete instead of edit is to get around not being able to clear reactions
 }
 
 // Handles all selection related reactions
 func selectReactionHandler(s *discordgo.Session, r *discordgo.MessageReactionAdd, m *discordgo.Message, user *discordgo.User, gameString string) {
+	l := newLog("reaction:select", parseDetails(gameString).Game, r.GuildID, r.UserID)
+	lang := languageOf(r.UserID, "")
 
 	if r.Emoji.Name == "✅" { // Only verfiy if the user is confirming it
 		// Prevent reaction spam
-		s.MessageReactionRemove(r.ChannelID, r.MessageID, r.Emoji.Name, s.State.User.ID)
-		defer s.MessageReactionAdd(r.ChannelID, r.MessageID, r.Emoji.Name) // Add it back after if there is an error
+		l.check(s.MessageReactionRemove(r.ChannelID, r.MessageID, r.Emoji.Name, s.State.User.ID))
+		defer func() { l.check(s.MessageReactionAdd(r.ChannelID, r.MessageID, r.Emoji.Name)) }() // Add it back after if there is an error
 
 		// Get the users reactions that the bot has also put on
 		var userReactions []*discordgo.MessageReactions
@@ -102,7 +102,7 @@ func selectReactionHandler(s *discordgo.Session, r *discordgo.MessageReactionAdd
 		// Make sure right length
 		if len(userReactions) != 2 {
 			// Send error message to warn them of their mistake
-			s.ChannelMessageSend(r.ChannelID, errorMessage("Invalid reactions", "Ensure you have reacted with 1 letter and 1 number. Adjust your reactions and try again."))
+			l.message(s.ChannelMessageSend(r.ChannelID, errorMessage(i18n.T(lang, "select.error.title"), i18n.T(lang, "select.error"))))
 			return
 		}
 
@@ -122,25 +122,35 @@ func selectReactionHandler(s *discordgo.Session, r *discordgo.MessageReactionAdd
 			x = x2
 		} else {
 			// Most likely reacted with 2 numbers or 2 letters
-			s.ChannelMessageSend(r.ChannelID, errorMessage("Invalid reactions", "Ensure you reacted with 1 letter and 1 number. Adjust your reactions and try again."))
+			l.message(s.ChannelMessageSend(r.ChannelID, errorMessage(i18n.T(lang, "select.error.title"), i18n.T(lang, "select.error"))))
 			return
 		}
 
 		// Get game
 		opponentID, game, err := ParseGame(gameString)
 		if err != nil {
+			l.Error("Could not read the board", "err", err)
+			return
+		}
+		details := parseDetails(gameString)
+		if isStale(&game, details) {
+			sendStale(s, l, lang, r.ChannelID, r.MessageID)
 			return
 		}
 
 		// Get selection
-		square, _ := logic.SquareAtCoords(x, y, &game)
-		moves, err := square.GetAvailableMoves(&game, false)
+		square, err := logic.SquareAtCoords(x, y, &game)
+		if err != nil {
+			l.message(s.ChannelMessageSend(r.ChannelID, errorMessage(formatError(lang, err), i18n.T(lang, "select.error.retry"))))
+			return
+		}
+		moves, err := square.GetAvailableMoves(&game)
 		if err != nil {
-			s.ChannelMessageSend(r.ChannelID, errorMessage(err.Error(), "Adjust your reactions and try again."))
+			l.message(s.ChannelMessageSend(r.ChannelID, errorMessage(formatError(lang, err), i18n.T(lang, "select.error.retry"))))
 			return
 		}
 
 		// If all is good, then we can get the available moves
-		selectPiece(s, r.ChannelID, r.MessageID, opponentID, &game, &square, &moves, false)
+		selectPiece(s, l, r.GuildID, r.ChannelID, r.MessageID, r.UserID, opponentID, &game, details, &square, &moves, false)
 	}
 }
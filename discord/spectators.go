@@ -0,0 +1,95 @@
+package discord
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/render"
+)
+
+// Handlers/Functions for the boards posted in the spectator channel of a server
+
+// A board showing a game to the members of a server
+type spectatorBoard struct {
+	GuildID   string // The server the game was started in, its theme is used for the board
+	ChannelID string // The spectator channel of the server
+	MessageID string // The board, edited after every move
+}
+
+// Creates an embed showing a game to spectators in the language and theme of a server, the board is always seen from the side of blue.
+// The result is empty while the game is being played
+func spectatorEmbed(lang string, g games.Game, result string, t boardTheme) *discordgo.MessageEmbed {
+	position := g.Position()
+	board := boardFor(&position, c_PLAYER_BLUE)
+
+	description := formatColor(lang, c_PLAYER_BLUE) + ": " + g.Players[c_PLAYER_BLUE].Name + "\n" + formatColor(lang, c_PLAYER_RED) + ": " + g.Players[c_PLAYER_RED].Name
+	if g.TimeControl.Timed() {
+		description += "\n" + i18n.T(lang, "invite.time", g.TimeControl.String())
+	}
+	if t.Name != render.CLASSIC {
+		description += "\n" + formatTheme(lang, t)
+	}
+	status := i18n.T(lang, "spectate.turn", formatColor(lang, g.Turn))
+	color := c_DEFAULT
+	if result != "" {
+		status = result
+		color = c_GOLD
+	}
+	lastMove := i18n.T(lang, "game.none")
+	if len(g.Moves) != 0 {
+		lastMove = g.Moves[len(g.Moves)-1]
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "spectate.title", variantTitle(lang, position.Rules())),
+		Description: description,
+		Color:       color,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: i18n.T(lang, "game.status"), Value: status},
+			{Name: i18n.T(lang, "spectate.last"), Value: lastMove},
+			{Name: i18n.T(lang, "game.board"), Value: formatBoard(&board, position.Rules(), nil, t)},
+		},
+	}
+}
+
+// Posts a game in the spectator channel of the server it was started in, if the server has one
+func startSpectating(s *discordgo.Session, guildID string, gameID string) {
+	channelID := getGuildConfig(guildID).SpectatorChannel
+	if channelID == "" || gameID == "" {
+		return
+	}
+	g, ok := gameService.Store.Get(gameID)
+	if !ok {
+		return
+	}
+
+	l := newLog("spectate", gameID, guildID)
+	m, err := s.ChannelMessageSendEmbed(channelID, spectatorEmbed(languageOf("", guildID), g, "", guildTheme(guildID)))
+	if !l.check(err) {
+		return
+	}
+	if err := store.Put("spectators", gameID, spectatorBoard{GuildID: guildID, ChannelID: channelID, MessageID: m.ID}); err != nil {
+		l.Error("Could not save the spectator board", "err", err)
+	}
+}
+
+// Shows the new position of a game to spectators, the board stops being updated once there is a result.
+// The result is written in the language of the server, nil while the game is being played
+func updateSpectators(s *discordgo.Session, g games.Game, result func(lang string) string) {
+	var board spectatorBoard
+	if g.ID == "" || !store.Get("spectators", g.ID, &board) {
+		return
+	}
+
+	lang := languageOf("", board.GuildID)
+	status := ""
+	if result != nil {
+		status = result(lang)
+	}
+	queueEdit(s, board.ChannelID, board.MessageID, spectatorEmbed(lang, g, status, guildTheme(board.GuildID)))
+	if result != nil {
+		if err := store.Delete("spectators", g.ID); err != nil {
+			newLog("spectate", g.ID, board.GuildID).Error("Could not stop updating the spectator board", "err", err)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package discord
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/stats"
+)
+
+// Shows the record of the mentioned user, or of the sender if nobody was mentioned
+func statsCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate) {
+	l := newLog("command:stats", "", m.GuildID, m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
+	user := m.Author
+	if len(m.Mentions) == 1 {
+		user = m.Mentions[0]
+	}
+
+	r := stats.Get(store, user.ID)
+	l.message(s.ChannelMessageSendEmbed(m.ChannelID, &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "stats.title", formatUser(user)),
+		Description: i18n.T(lang, "stats", r.Played, r.Won, r.Lost),
+		Color:       c_BLUE,
+	}))
+}
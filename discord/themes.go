@@ -0,0 +1,205 @@
+package discord
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/render"
+)
+
+// Handlers/Functions for the look of boards
+
+// The longest value of an embed field, boards of custom emojis can be longer and fall back to the classic theme
+const c_FIELD_LIMIT = 1024
+
+// The emojis a board is made of
+type emojiTheme struct {
+	Dark   string    // Squares the pieces stand on
+	Light  string    // Squares that are never played on
+	Pieces [4]string // Blue men, red men, blue kings and red kings, in the order of the board values
+}
+
+// Every emoji theme by the name of the matching image theme, custom themes are made from the classic one
+var emojiThemes = map[string]emojiTheme{
+	render.CLASSIC:  {Dark: "⬛", Light: "⬜", Pieces: [4]string{"🔵", "🔴", "💙", "❤️"}},
+	render.SHAPES:   {Dark: "⬛", Light: "⬜", Pieces: [4]string{"⚪", "🔶", "🔘", "💠"}}, // Kings keep the shape of their men with a dot, like the crown on images
+	render.CONTRAST: {Dark: "🟫", Light: "⬜", Pieces: [4]string{"⚪", "⚫", "🤍", "🖤"}},
+}
+
+// Names of the themes in the order they are listed
+var themeNames = []string{render.CLASSIC, render.SHAPES, render.CONTRAST, render.CUSTOM}
+
+// Matches a custom emoji and captures its ID
+var customEmoji = regexp.MustCompile(`^<a?:\w+:(\d+)>$`)
+
+// The marker each move reaction is drawn as on images
+var markerCodes = map[string]string{
+	"↖️": "nw", "↗️": "ne", "↙️": "sw", "↘️": "se", "🎯": render.MARK_TARGET,
+	"🟥": "red", "🟧": "orange", "🟨": "yellow", "🟩": "green", "🟦": "blue", "🟪": "purple", "🟫": "brown",
+}
+
+// Numbered move reactions are drawn as their number
+func init() {
+	for i := 0; i < 10; i++ {
+		markerCodes[numbersSlice[i]] = strconv.Itoa(i + 1)
+	}
+}
+
+// The look of the boards someone is sent
+type boardTheme struct {
+	Name   string     // The name of the theme, one of themeNames
+	Emojis emojiTheme // The emojis of the theme, the custom emojis of a server for custom themes
+	Images bool       // If boards are shown as images, only works when the web board runs
+}
+
+// Preferences of a user
+type userPrefs struct {
+	Theme      string // The theme of the boards sent to the user, empty for classic
+	Guild      string // The server whose emojis are used by the custom theme
+	Images     bool   // If boards are shown as images
+	Language   string // The language the bot talks to the user in, empty for the language of the server
+	Accessible bool   // If boards are described in words for screen readers
+}
+
+// Gets the preferences of a user
+func getUserPrefs(userID string) userPrefs {
+	var prefs userPrefs
+	store.Get("users", userID, &prefs)
+	return prefs
+}
+
+// Gets a theme by name, custom themes use the emojis of a server and are classic if it has none
+func themeOf(name string, guildID string) boardTheme {
+	if name == render.CUSTOM {
+		if emojis := getGuildConfig(guildID).Emojis; len(emojis) == 4 {
+			t := boardTheme{Name: render.CUSTOM, Emojis: emojiThemes[render.CLASSIC]}
+			copy(t.Emojis.Pieces[:], emojis)
+			return t
+		}
+	}
+	if e, ok := emojiThemes[name]; ok {
+		return boardTheme{Name: name, Emojis: e}
+	}
+	return boardTheme{Name: render.CLASSIC, Emojis: emojiThemes[render.CLASSIC]}
+}
+
+// Gets the theme of the boards sent to a user
+func userTheme(userID string) boardTheme {
+	prefs := getUserPrefs(userID)
+	t := themeOf(prefs.Theme, prefs.Guild)
+	t.Images = prefs.Images
+	return t
+}
+
+// Gets the theme of the boards posted in a server
+func guildTheme(guildID string) boardTheme {
+	return themeOf(getGuildConfig(guildID).Theme, guildID)
+}
+
+// Gets the link to the image of a board, empty if the board isn't shown as an image
+func boardImageURL(board string, r logic.Rules, markers map[uint8]string, t boardTheme) string {
+	if !t.Images || webURL == "" {
+		return ""
+	}
+
+	q := url.Values{}
+	q.Set("board", board)
+	q.Set("variant", r.Name())
+	q.Set("theme", t.Name)
+	var marks []string
+	for index, marker := range markers {
+		if code, ok := markerCodes[marker]; ok {
+			marks = append(marks, strconv.Itoa(int(index))+":"+code)
+		}
+	}
+	if len(marks) > 0 {
+		sort.Strings(marks) // The same board always gets the same link so Discord can cache it
+		q.Set("marks", strings.Join(marks, ","))
+	}
+	if t.Name == render.CUSTOM {
+		var ids []string
+		for _, e := range t.Emojis.Pieces {
+			id := "-"
+			if match := customEmoji.FindStringSubmatch(e); match != nil {
+				id = match[1]
+			}
+			ids = append(ids, id)
+		}
+		q.Set("emojis", strings.Join(ids, ","))
+	}
+	return webURL + "/board.png?" + q.Encode()
+}
+
+// Lists the names of the themes as code
+func formatThemeNames() string {
+	return "`" + strings.Join(themeNames, "`, `") + "`"
+}
+
+// Describes the pieces of a theme
+func formatTheme(lang string, t boardTheme) string {
+	p := t.Emojis.Pieces
+	return i18n.T(lang, "theme.pieces", formatColor(lang, c_PLAYER_BLUE), p[0], p[2]) + "\n" + i18n.T(lang, "theme.pieces", formatColor(lang, c_PLAYER_RED), p[1], p[3])
+}
+
+// Shows or changes the theme of the boards sent to a user
+func themeCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+	l := newLog("command:theme", "", m.GuildID, m.Author.ID)
+	prefs := getUserPrefs(m.Author.ID)
+	prefix := getGuildConfig(m.GuildID).prefix()
+	lang := languageOf(m.Author.ID, m.GuildID)
+
+	if len(args) > 1 {
+		switch name := strings.ToLower(args[1]); {
+		case name == "images":
+			if len(args) < 3 || (args[2] != "on" && args[2] != "off") {
+				l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "theme.error.images.title"), i18n.T(lang, "theme.error.images", prefix))))
+				return
+			}
+			prefs.Images = args[2] == "on"
+		case name == render.CUSTOM:
+			if len(getGuildConfig(m.GuildID).Emojis) != 4 {
+				l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "theme.error.custom.title"), i18n.T(lang, "theme.error.custom", prefix))))
+				return
+			}
+			prefs.Theme, prefs.Guild = name, m.GuildID
+		default:
+			if _, ok := emojiThemes[name]; !ok {
+				l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "theme.error.title"), i18n.T(lang, "theme.error", formatThemeNames()))))
+				return
+			}
+			prefs.Theme, prefs.Guild = name, ""
+		}
+
+		if err := store.Put("users", m.Author.ID, prefs); err != nil {
+			l.Error("Could not save the theme", "err", err)
+			l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "theme.error.save"))))
+			return
+		}
+	}
+
+	t := userTheme(m.Author.ID)
+	images := i18n.T(lang, "theme.images.off")
+	if t.Images {
+		images = i18n.T(lang, "theme.images.on")
+		if webURL == "" {
+			images = i18n.T(lang, "theme.images.web")
+		}
+	}
+	board := logic.StartingBoard(logic.Variants[0])
+	l.message(s.ChannelMessageSendEmbed(m.ChannelID, &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "theme.title", t.Name),
+		Description: formatTheme(lang, t),
+		Color:       c_BLUE,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: i18n.T(lang, "game.board"), Value: formatBoard(&board, logic.Variants[0], nil, t)},
+			{Name: i18n.T(lang, "theme.images.title"), Value: images},
+			{Name: i18n.T(lang, "theme.change.title"), Value: i18n.T(lang, "theme.change", prefix, formatThemeNames())},
+		},
+	}))
+}
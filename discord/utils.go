@@ -36,6 +36,12 @@ const (
 	c_DARK_VIVID_PINK     = 12320855
 )
 
+// Player Enum, matches the turn numbers used by the logic package
+const (
+	c_PLAYER_BLUE uint8 = 1
+	c_PLAYER_RED  uint8 = 2
+)
+
 // Get the reactions preset by the bot
 func getBotReactions(reactions []*discordgo.MessageReactions) []*discordgo.MessageReactions {
 	var botReactions []*discordgo.MessageReactions
@@ -78,7 +84,10 @@ func ParseGame(s string) (string, logic.Game, error) {
 	var game logic.Game
 	values := strings.Split(s, " ")
 
-	if len(values) != 4 {
+	// Games started before variants were added don't have one
+	if len(values) >= 5 && len(values) <= 7 {
+		game.Variant = values[4]
+	} else if len(values) != 4 {
 		return "", logic.Game{}, errors.New("Invalid input")
 	}
 
@@ -105,7 +114,99 @@ func StringifyGame(opponentID string, game *logic.Game) string {
 		strconv.FormatUint(uint64(game.Turn), 10),
 		game.Board,
 		strconv.FormatUint(uint64(game.Selected), 10),
+		game.Rules().Name(),
+	}
+
+	return strings.Join(values, " ")
+}
+
+// Most characters of moves kept in a footer, Discord doesn't send footers over 2048 characters and the rest of the footer needs some room
+const c_FOOTER_MOVES = 1700
+
+// Details of a game that aren't part of the position
+type gameDetails struct {
+	Casual bool     // Casual games are just for fun and allow hints
+	Hints  bool     // If the players can ask for hints
+	Puzzle int      // The number of the puzzle being solved, 0 for regular games
+	Game   string   // ID of the game in the game store, empty for games that aren't saved
+	Moves  []string // Every move made so far in standard notation
+}
+
+// Parses the details at the end of a game string, games started before details were added have none.
+// Saved games only have the number of moves made in the string and the moves are read from the store
+func parseDetails(s string) gameDetails {
+	var details gameDetails
+	values := strings.Split(s, " ")
+	if len(values) < 6 {
+		return details
 	}
 
+	saved := -1 // Boards sent before the moves were read from the store have every move in the string
+	for _, flag := range strings.Split(values[5], ",") {
+		switch flag {
+		case "casual":
+			details.Casual = true
+		case "hints":
+			details.Hints = true
+		default:
+			if strings.HasPrefix(flag, "puzzle=") {
+				details.Puzzle, _ = strconv.Atoi(flag[len("puzzle="):])
+			} else if strings.HasPrefix(flag, "game=") {
+				details.Game = flag[len("game="):]
+			} else if strings.HasPrefix(flag, "moves=") {
+				saved, _ = strconv.Atoi(flag[len("moves="):])
+			}
+		}
+	}
+
+	if len(values) > 6 && values[6] != "-" {
+		details.Moves = strings.Split(values[6], ",")
+	}
+
+	// The moves of the board are the ones saved when it was sent, followed by a multi jump that is still being made
+	if details.Game != "" && saved >= 0 {
+		if g, ok := gameService.Store.Get(details.Game); ok && len(g.Moves) >= saved {
+			details.Moves = append(append([]string{}, g.Moves[:saved]...), details.Moves...)
+		}
+	}
+	return details
+}
+
+// Stringifies the details of a game on a board to go after the game string.
+// Saved games only keep the number of moves made so long games fit in the footer, a multi jump that is being made isn't saved yet so it is kept
+func stringifyDetails(details gameDetails, game *logic.Game) string {
+	moves := details.Moves
+	var flags []string
+	if details.Casual {
+		flags = append(flags, "casual")
+	}
+	if details.Hints {
+		flags = append(flags, "hints")
+	}
+	if details.Puzzle != 0 {
+		flags = append(flags, "puzzle="+strconv.Itoa(details.Puzzle))
+	}
+	if details.Game != "" {
+		saved := len(moves)
+		if logic.IsMultiJump(game) && saved > 0 {
+			saved--
+		}
+		flags = append(flags, "game="+details.Game, "moves="+strconv.Itoa(saved))
+		moves = moves[saved:]
+	}
+
+	// Values can't be left empty as they are separated by spaces
+	values := []string{"-", "-"}
+	if len(flags) > 0 {
+		values[0] = strings.Join(flags, ",")
+	}
+	if len(moves) > 0 {
+		values[1] = strings.Join(moves, ",")
+	}
+
+	// Games that couldn't be saved lose their moves rather than the board once the footer would be too long to send
+	if len(values[1]) > c_FOOTER_MOVES {
+		values[1] = "-"
+	}
 	return strings.Join(values, " ")
 }
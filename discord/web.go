@@ -0,0 +1,104 @@
+package discord
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Handlers/Functions for games that can also be played on the web
+
+// Applies moves and saves games, shared with the web server once UseGames is called
+var gameService = games.NewService(games.New(store))
+
+// Address of the web board, empty if the bot doesn't run one
+var webURL string
+
+// Shares games with the web server and sends the boards and messages that follow moves, has to be called before the bot connects
+func UseGames(s *discordgo.Session, g *games.Service, url string) {
+	gameService = g
+	webURL = strings.TrimSuffix(url, "/")
+	g.Bus.SubscribeAsync(func(e games.Event) {
+		gameEventHandler(s, e)
+	})
+}
+
+// Saves a game that was just started so it can be played on the web too, returns the ID of the game
+func saveGame(game *logic.Game, players map[uint8]*discordgo.User, details gameDetails, control games.TimeControl) string {
+	saved := make(map[uint8]games.Player)
+	for number, u := range players {
+		saved[number] = games.Player{DiscordID: u.ID, Name: formatUser(u)}
+	}
+
+	g, err := gameService.Create(*game, saved, details.Moves, details.Casual, control, games.SOURCE_DISCORD, nil)
+	if err != nil {
+		var userIDs []string
+		for _, u := range players {
+			userIDs = append(userIDs, u.ID)
+		}
+		newLog("save_game", "", "", userIDs...).Error("Could not save the game", "err", err)
+		return ""
+	}
+	return g.ID
+}
+
+// Checks if a board is out of date because the game went on without it, the game has to be the position on the board
+func isStale(game *logic.Game, details gameDetails) bool {
+	if details.Game == "" {
+		return false
+	}
+	g, ok := gameService.Store.Get(details.Game)
+	if !ok {
+		return false
+	}
+
+	// A multi jump that is being made has already been added to the moves
+	moves := len(details.Moves)
+	if logic.IsMultiJump(game) {
+		moves--
+	}
+	return g.Over() || moves != len(g.Moves)
+}
+
+// Tells a player their board is out of date
+func sendStale(s *discordgo.Session, l handlerLog, lang string, channelID string, messageID string) {
+	l.message(s.ChannelMessageEdit(channelID, messageID, errorMessage(i18n.T(lang, "web.stale.title"), i18n.T(lang, "web.stale"))))
+}
+
+// Sends a user links to play their games on the web
+func webCommandHandler(s *discordgo.Session, m *discordgo.MessageCreate) {
+	l := newLog("command:web", "", m.GuildID, m.Author.ID)
+	lang := languageOf(m.Author.ID, m.GuildID)
+	if webURL == "" {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "web.error.title"), i18n.T(lang, "web.error"))))
+		return
+	}
+
+	var links []string
+	for _, g := range gameService.Store.List() {
+		player := g.PlayerWithDiscordID(m.Author.ID)
+		if player == 0 || g.Over() {
+			continue
+		}
+		link := webURL + "/#/games/" + g.ID + "?token=" + g.Players[player].Token
+		links = append(links, i18n.T(lang, "web.link", g.Players[3-player].Name, formatColor(lang, player), link))
+	}
+	if len(links) == 0 {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "web.error.games.title"), i18n.T(lang, "web.error.games"))))
+		return
+	}
+
+	// The links let anyone move for the player so they are only sent in DMs
+	dm, err := s.UserChannelCreate(m.Author.ID)
+	if !l.check(err) {
+		l.message(s.ChannelMessageSend(m.ChannelID, errorMessage(i18n.T(lang, "error.bot"), i18n.T(lang, "error.dm"))))
+		return
+	}
+	l.message(s.ChannelMessageSend(dm.ID, successMessage(i18n.T(lang, "web.title"), i18n.T(lang, "web.links", strings.Join(links, "\n")))))
+	if dm.ID != m.ChannelID {
+		l.message(s.ChannelMessageSend(m.ChannelID, successMessage(i18n.T(lang, "web.sent.title"), i18n.T(lang, "web.sent"))))
+	}
+}
@@ -0,0 +1,33 @@
+package discord
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/openings"
+	"github.com/jmsheff/discord-checkers/webhooks"
+)
+
+// Sends events to other sites, nil if no webhooks are set up
+var hooks *webhooks.Dispatcher
+
+// Sets where events about invites are sent, events about games are sent by the game store
+func UseWebhooks(d *webhooks.Dispatcher) {
+	hooks = d
+}
+
+// Sends an event for an invite, the recipient is nil for general invites
+func sendInviteEvent(m *discordgo.MessageCreate, recipient *discordgo.User, options inviteOptions) {
+	e := webhooks.InviteSent{
+		GuildID:   m.GuildID,
+		ChannelID: m.ChannelID,
+		From:      webhooks.Player{DiscordID: m.Author.ID, Name: formatUser(m.Author), Color: colorName(options.Color)},
+		Variant:   options.Rules.Name(),
+		Casual:    options.Casual,
+	}
+	if recipient != nil {
+		e.To = &webhooks.Player{DiscordID: recipient.ID, Name: formatUser(recipient), Color: colorName(otherColor(options.Color))}
+	}
+	if options.Ballot != 0 {
+		e.Ballot = openings.Ballots[options.Ballot-1]
+	}
+	hooks.Send(webhooks.INVITE_SENT, e)
+}
@@ -0,0 +1,56 @@
+package dxp
+
+import (
+	"bufio"
+	"net"
+	"time"
+)
+
+// The port DXP engines usually listen on
+const PORT = 27531
+
+// A connection to another engine, messages end with a null character
+type Conn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// Wraps a network connection
+func NewConn(c net.Conn) *Conn {
+	return &Conn{conn: c, reader: bufio.NewReader(c)}
+}
+
+// Connects to an engine listening at an address
+func Dial(address string) (*Conn, error) {
+	c, err := net.Dial("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	return NewConn(c), nil
+}
+
+// Reads the next message, waits at most the timeout unless it is 0
+func (c *Conn) Read(timeout time.Duration) (Message, error) {
+	if timeout > 0 {
+		c.conn.SetReadDeadline(time.Now().Add(timeout))
+	} else {
+		c.conn.SetReadDeadline(time.Time{})
+	}
+
+	s, err := c.reader.ReadString(0)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(s[:len(s)-1])
+}
+
+// Sends a message
+func (c *Conn) Write(m Message) error {
+	_, err := c.conn.Write([]byte(m.Encode() + "\x00"))
+	return err
+}
+
+// Closes the connection
+func (c *Conn) Close() error {
+	return c.conn.Close()
+}
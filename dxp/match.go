@@ -0,0 +1,342 @@
+package dxp
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/engine"
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/pdn"
+)
+
+// How long to wait for the other engine to confirm the end of a game
+const END_TIMEOUT = 5 * time.Second
+
+// Chooses the moves played by our side
+type Player interface {
+	Move(game logic.Game) (logic.Sequence, error)
+}
+
+// Plays the best move found by an engine
+type EnginePlayer struct {
+	Engine *engine.Engine
+}
+
+// Gets the best move from the engine
+func (p EnginePlayer) Move(game logic.Game) (logic.Sequence, error) {
+	result, err := p.Engine.BestMove(game)
+	return result.Move, err
+}
+
+// Settings of a game
+type Settings struct {
+	Name     string      // Our name
+	Rules    logic.Rules // The variant played, DXP is made for international draughts
+	Time     int         // Thinking time in minutes
+	Moves    int         // Number of moves that have to be made in the thinking time
+	MaxPlies int         // The game is a draw after this many plies, 0 for no limit
+}
+
+// Gets the color a player has in the protocol
+func colorOf(player uint8, r logic.Rules) byte {
+	if player == logic.White(r) {
+		return WHITE
+	}
+	return BLACK
+}
+
+// Gets the player with a color in the protocol
+func playerOf(color byte, r logic.Rules) uint8 {
+	if color == WHITE {
+		return logic.White(r)
+	}
+	return 3 - logic.White(r)
+}
+
+// Writes the pieces of a position in order of the square numbers
+func boardOf(game *logic.Game) string {
+	var board strings.Builder
+	white := logic.White(game.Rules())
+	for n := 1; n <= len(game.Board); n++ {
+		s, _ := logic.SquareAtNumber(n, game)
+		switch {
+		case s.IsEmpty() || s.IsCaptured():
+			board.WriteByte('e')
+		case s.Player() == white && s.IsKing():
+			board.WriteByte('W')
+		case s.Player() == white:
+			board.WriteByte('w')
+		case s.IsKing():
+			board.WriteByte('Z')
+		default:
+			board.WriteByte('z')
+		}
+	}
+
+	return board.String()
+}
+
+// Reads the position of a game request
+func gameOf(req GameRequest, r logic.Rules) (logic.Game, error) {
+	if req.Start {
+		return logic.NewGame(r), nil
+	}
+
+	game := logic.NewGame(r)
+	if len(req.Board) != len(game.Board) {
+		return logic.Game{}, errors.New("The position doesn't fit on the board")
+	}
+	game.Turn = playerOf(req.ToMove, r)
+
+	board := []byte(game.Board)
+	white := logic.White(r)
+	for i := range req.Board {
+		s, _ := logic.SquareAtNumber(i+1, &game)
+		switch req.Board[i] {
+		case 'e':
+			board[s.Index] = '0'
+		case 'w':
+			board[s.Index] = '0' + white
+		case 'W':
+			board[s.Index] = '0' + white + 2
+		case 'z':
+			board[s.Index] = '0' + 3 - white
+		case 'Z':
+			board[s.Index] = '0' + 5 - white
+		default:
+			return logic.Game{}, errors.New("Invalid piece in the position")
+		}
+	}
+	game.Board = string(board)
+
+	return game, nil
+}
+
+// Writes a sequence as a move
+func moveOf(seq logic.Sequence, game *logic.Game, spent time.Duration) Move {
+	m := Move{
+		Time: int(spent.Seconds()),
+		From: logic.SquareNumber(seq.From.Index, game),
+		To:   logic.SquareNumber(seq.To().Index, game),
+	}
+	for _, step := range seq.Steps {
+		if step.IsJump() {
+			m.Captures = append(m.Captures, logic.SquareNumber(step.Jumped.Index, game))
+		}
+	}
+
+	return m
+}
+
+// Finds the sequence a move was written from
+func sequenceOf(m Move, game *logic.Game) (logic.Sequence, error) {
+	// The captures can be listed in any order
+	for _, seq := range logic.GetSequences(game) {
+		candidate := moveOf(seq, game, 0)
+		if candidate.From == m.From && candidate.To == m.To && sameSquares(candidate.Captures, m.Captures) {
+			return seq, nil
+		}
+	}
+
+	return logic.Sequence{}, errors.New("Illegal move")
+}
+
+// Checks if two lists have the same squares in any order
+func sameSquares(a []int, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	count := make(map[int]int)
+	for _, n := range a {
+		count[n]++
+	}
+	for _, n := range b {
+		if count[n] == 0 {
+			return false
+		}
+		count[n]--
+	}
+	return true
+}
+
+// Asks the engine listening on the other end of a connection to play a game, we play the given color
+func Initiate(c *Conn, settings Settings, color uint8, start logic.Game, player Player) (pdn.Game, error) {
+	req := GameRequest{
+		Version:       VERSION,
+		Name:          settings.Name,
+		FollowerColor: colorOf(3-color, settings.Rules),
+		Time:          settings.Time,
+		Moves:         settings.Moves,
+		Start:         start.Board == logic.StartingBoard(settings.Rules) && start.Turn == logic.NewGame(settings.Rules).Turn,
+		ToMove:        colorOf(start.Turn, settings.Rules),
+		Board:         boardOf(&start),
+	}
+	if err := c.Write(req); err != nil {
+		return pdn.Game{}, err
+	}
+
+	for {
+		m, err := c.Read(0)
+		if err != nil {
+			return pdn.Game{}, err
+		}
+
+		switch m := m.(type) {
+		case GameAccept:
+			if m.Code != ACCEPT {
+				return pdn.Game{}, errors.New("Game refused by " + m.Name)
+			}
+			return play(c, settings, start, color, player, settings.Name, m.Name)
+		case Chat:
+			continue
+		default:
+			return pdn.Game{}, errors.New("Expected the game to be accepted")
+		}
+	}
+}
+
+// Waits for the engine on the other end of a connection to ask for a game and plays it.
+// Returns io.EOF if the connection is closed before a game is asked for
+func Follow(c *Conn, settings Settings, player Player) (pdn.Game, error) {
+	for {
+		m, err := c.Read(0)
+		if err != nil {
+			return pdn.Game{}, err
+		}
+
+		switch m := m.(type) {
+		case GameRequest:
+			start, err := gameOf(m, settings.Rules)
+			if err != nil {
+				c.Write(GameAccept{Name: settings.Name, Code: REFUSE_UNEXPLAINED})
+				return pdn.Game{}, err
+			}
+			if err := c.Write(GameAccept{Name: settings.Name, Code: ACCEPT}); err != nil {
+				return pdn.Game{}, err
+			}
+			return play(c, settings, start, playerOf(m.FollowerColor, settings.Rules), player, settings.Name, m.Name)
+		case Chat:
+			continue
+		case GameEnd:
+			// The other engine doesn't want to play another game
+			return pdn.Game{}, io.EOF
+		default:
+			return pdn.Game{}, errors.New("Expected a game request")
+		}
+	}
+}
+
+// Plays a game until one side can't move or one engine ends it
+func play(c *Conn, settings Settings, start logic.Game, color uint8, player Player, name string, opponent string) (pdn.Game, error) {
+	game := start
+	var moves []string
+	var result string
+	var err error
+
+	// Everything after the game is set up is the same for both sides
+	finish := func() (pdn.Game, error) {
+		white, black := name, opponent
+		if color != logic.White(settings.Rules) {
+			white, black = opponent, name
+		}
+
+		record := pdn.New(settings.Rules, white, black, moves, result)
+		record.SetTag("Event", "DXP match")
+		record.SetTag("Date", time.Now().Format("2006.01.02"))
+		if start.Board != logic.StartingBoard(settings.Rules) || start.Turn != logic.NewGame(settings.Rules).Turn {
+			record.SetTag("FEN", logic.FormatFEN(&start))
+		}
+		return record, err
+	}
+
+	// Ends the game from our side and waits for the other engine to confirm
+	end := func(reason byte) (pdn.Game, error) {
+		result = resultOf(reason, color, settings.Rules)
+		if err = c.Write(GameEnd{Reason: reason, Stop: '0'}); err == nil {
+			c.Read(END_TIMEOUT)
+		}
+		return finish()
+	}
+
+	for {
+		if !logic.HasMoves(&game) {
+			// The player to move loses, or wins in inverted variants
+			won := (game.Turn == color) == game.Rules().Inverted()
+			if won {
+				return end(END_WIN)
+			}
+			return end(END_LOSS)
+		}
+		if settings.MaxPlies > 0 && len(moves) >= settings.MaxPlies {
+			return end(END_DRAW)
+		}
+
+		if game.Turn == color {
+			started := time.Now()
+			seq, moveErr := player.Move(game)
+			if moveErr != nil {
+				err = moveErr
+				return end(END_UNKNOWN)
+			}
+			if err = c.Write(moveOf(seq, &game, time.Since(started))); err != nil {
+				return finish()
+			}
+			moves = append(moves, logic.FormatSequence(seq, &game))
+			logic.ApplySequence(seq, &game)
+			logic.SwapTurn(&game)
+			continue
+		}
+
+		m, readErr := c.Read(0)
+		if readErr != nil {
+			err = readErr
+			result = pdn.UNFINISHED
+			return finish()
+		}
+		switch m := m.(type) {
+		case Move:
+			seq, moveErr := sequenceOf(m, &game)
+			if moveErr != nil {
+				err = moveErr
+				return end(END_UNKNOWN)
+			}
+			moves = append(moves, logic.FormatSequence(seq, &game))
+			logic.ApplySequence(seq, &game)
+			logic.SwapTurn(&game)
+		case GameEnd:
+			// Confirm with the same result from our side
+			result = resultOf(opposite(m.Reason), color, settings.Rules)
+			c.Write(GameEnd{Reason: opposite(m.Reason), Stop: '0'})
+			return finish()
+		case BackRequest:
+			c.Write(BackAccept{Code: '1'})
+		}
+	}
+}
+
+// Gets the reason for the end of a game from the point of view of the other engine
+func opposite(reason byte) byte {
+	switch reason {
+	case END_WIN:
+		return END_LOSS
+	case END_LOSS:
+		return END_WIN
+	}
+	return reason
+}
+
+// Gets the PDN result from the reason we ended a game for
+func resultOf(reason byte, color uint8, r logic.Rules) string {
+	switch reason {
+	case END_WIN:
+		return pdn.Result(color, r)
+	case END_LOSS:
+		return pdn.Result(3-color, r)
+	case END_DRAW:
+		return pdn.DRAW
+	}
+	return pdn.UNFINISHED
+}
@@ -0,0 +1,245 @@
+package dxp
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Colors used by the protocol, Z is for zwart(black)
+const (
+	WHITE byte = 'W'
+	BLACK byte = 'Z'
+)
+
+// Codes a follower answers a game request with
+const (
+	ACCEPT             byte = '0'
+	REFUSE_COLOR       byte = '1'
+	REFUSE_TIME        byte = '2'
+	REFUSE_MOVES       byte = '3'
+	REFUSE_UNEXPLAINED byte = '9'
+)
+
+// Reasons for ending a game, from the point of view of the player sending the message
+const (
+	END_UNKNOWN byte = '0'
+	END_LOSS    byte = '1'
+	END_DRAW    byte = '2'
+	END_WIN     byte = '3'
+)
+
+// The version of the protocol implemented
+const VERSION = 1
+
+// The longest a name can be
+const NAME_LENGTH = 32
+
+// A message sent between two engines
+type Message interface {
+	Encode() string // Writes the message without the terminating null character
+}
+
+// Sent by the initiator to start a game
+type GameRequest struct {
+	Version       int    // Version of the protocol
+	Name          string // Name of the initiator
+	FollowerColor byte   // The color the follower plays
+	Time          int    // Thinking time in minutes
+	Moves         int    // Number of moves that have to be made in the thinking time
+	Start         bool   // If the game starts from the initial position
+	ToMove        byte   // The color to move when the game doesn't start from the initial position
+	Board         string // The pieces on each square in order of their numbers when the game doesn't start from the initial position
+}
+
+// Sent by the follower to answer a game request
+type GameAccept struct {
+	Name string // Name of the follower
+	Code byte   // ACCEPT or the reason the game was refused
+}
+
+// A move, every captured piece is listed so moves can't be ambiguous
+type Move struct {
+	Time     int   // Seconds spent thinking about the move
+	From     int   // Number of the square the piece moves from
+	To       int   // Number of the square the piece moves to
+	Captures []int // Numbers of the squares of the captured pieces
+}
+
+// Ends a game
+type GameEnd struct {
+	Reason byte // Why the game ended from the point of view of the sender
+	Stop   byte // '0' if the sender wants to play another game, '1' if not
+}
+
+// A text message
+type Chat struct {
+	Text string
+}
+
+// Asks to take back moves
+type BackRequest struct {
+	Move  int  // The move number to go back to
+	Color byte // The color to move after going back
+}
+
+// Answers a request to take back moves
+type BackAccept struct {
+	Code byte // '0' accepted, '1' not supported, '2' declined
+}
+
+// Pads or cuts a name to the length used by the protocol
+func padName(name string) string {
+	if len(name) > NAME_LENGTH {
+		return name[:NAME_LENGTH]
+	}
+	return name + strings.Repeat(" ", NAME_LENGTH-len(name))
+}
+
+// Writes a game request
+func (m GameRequest) Encode() string {
+	start := "A"
+	if !m.Start {
+		start = "B" + string(m.ToMove) + m.Board
+	}
+	return fmt.Sprintf("R%02d%s%c%03d%03d%s", m.Version, padName(m.Name), m.FollowerColor, m.Time, m.Moves, start)
+}
+
+// Writes an answer to a game request
+func (m GameAccept) Encode() string {
+	return "A" + padName(m.Name) + string(m.Code)
+}
+
+// Writes a move
+func (m Move) Encode() string {
+	s := fmt.Sprintf("M%04d%02d%02d%02d", m.Time, m.From, m.To, len(m.Captures))
+	for _, c := range m.Captures {
+		s += fmt.Sprintf("%02d", c)
+	}
+	return s
+}
+
+// Writes the end of a game
+func (m GameEnd) Encode() string {
+	return "E" + string(m.Reason) + string(m.Stop)
+}
+
+// Writes a text message
+func (m Chat) Encode() string {
+	return "C" + m.Text
+}
+
+// Writes a request to take back moves
+func (m BackRequest) Encode() string {
+	return fmt.Sprintf("B%03d%c", m.Move, m.Color)
+}
+
+// Writes an answer to a request to take back moves
+func (m BackAccept) Encode() string {
+	return "K" + string(m.Code)
+}
+
+// Reads numbers of fixed widths from the start of a string
+func readNumbers(s string, widths ...int) ([]int, error) {
+	var numbers []int
+	for _, w := range widths {
+		if len(s) < w {
+			return nil, errors.New("Message too short")
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(s[:w]))
+		if err != nil {
+			return nil, errors.New("Invalid number in message")
+		}
+		numbers = append(numbers, n)
+		s = s[w:]
+	}
+
+	return numbers, nil
+}
+
+// Parses a message without its terminating null character
+func Parse(s string) (Message, error) {
+	if s == "" {
+		return nil, errors.New("Empty message")
+	}
+
+	body := s[1:]
+	switch s[0] {
+	case 'R':
+		if len(body) < 2+NAME_LENGTH+1+3+3+1 {
+			return nil, errors.New("Game request too short")
+		}
+		numbers, err := readNumbers(body[:2], 2)
+		if err != nil {
+			return nil, err
+		}
+		m := GameRequest{Version: numbers[0], Name: strings.TrimSpace(body[2 : 2+NAME_LENGTH])}
+		body = body[2+NAME_LENGTH:]
+		m.FollowerColor = body[0]
+		if numbers, err = readNumbers(body[1:], 3, 3); err != nil {
+			return nil, err
+		}
+		m.Time, m.Moves = numbers[0], numbers[1]
+		body = body[7:]
+
+		switch body[0] {
+		case 'A':
+			m.Start = true
+		case 'B':
+			if len(body) < 3 {
+				return nil, errors.New("Game request is missing the position")
+			}
+			m.ToMove = body[1]
+			m.Board = body[2:]
+		default:
+			return nil, errors.New("Invalid starting position")
+		}
+		return m, nil
+	case 'A':
+		if len(body) < NAME_LENGTH+1 {
+			return nil, errors.New("Game accept too short")
+		}
+		return GameAccept{Name: strings.TrimSpace(body[:NAME_LENGTH]), Code: body[NAME_LENGTH]}, nil
+	case 'M':
+		numbers, err := readNumbers(body, 4, 2, 2, 2)
+		if err != nil {
+			return nil, err
+		}
+		m := Move{Time: numbers[0], From: numbers[1], To: numbers[2]}
+		if len(body) < 10+numbers[3]*2 {
+			return nil, errors.New("Move is missing captures")
+		}
+		for i := 0; i < numbers[3]; i++ {
+			c, err := readNumbers(body[10+i*2:], 2)
+			if err != nil {
+				return nil, err
+			}
+			m.Captures = append(m.Captures, c[0])
+		}
+		return m, nil
+	case 'E':
+		if len(body) < 2 {
+			return nil, errors.New("Game end too short")
+		}
+		return GameEnd{Reason: body[0], Stop: body[1]}, nil
+	case 'C':
+		return Chat{Text: body}, nil
+	case 'B':
+		if len(body) < 4 {
+			return nil, errors.New("Back request too short")
+		}
+		numbers, err := readNumbers(body, 3)
+		if err != nil {
+			return nil, err
+		}
+		return BackRequest{Move: numbers[0], Color: body[3]}, nil
+	case 'K':
+		if len(body) < 1 {
+			return nil, errors.New("Back accept too short")
+		}
+		return BackAccept{Code: body[0]}, nil
+	}
+
+	return nil, errors.New("Unknown message type " + string(s[0]))
+}
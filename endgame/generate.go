@@ -0,0 +1,317 @@
+package endgame
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// The positions of a material and its mirror, which are solved together since moves without a capture or a promotion go from one to the other
+type slice struct {
+	Materials []material
+	Indexers  []indexer
+	Offsets   []uint32 // Where the positions of each material start
+	Values    []byte   // The value of every position, or the value it is expected to get while it isn't solved
+	Solved    []bool   // Whether the value of a position is final
+	Children  []uint16 // Number of moves to positions in the slice that aren't known to be wins for the other player, flying kings can have more than fit in a byte
+}
+
+// A position of a slice expected to be decided with a value
+type decision struct {
+	ID    uint32
+	Value byte
+}
+
+// Builds the tablebase of a variant for positions with up to a number of pieces.
+// Positions are solved by retrograde analysis one number of pieces at a time, since captures always lead to positions that have already been solved.
+// Progress is called after each number of pieces is done
+func Generate(r logic.Rules, pieces int, progress func(pieces int, positions int)) (*Tablebase, error) {
+	if pieces < 2 {
+		return nil, errors.New("Tablebases need at least 2 pieces")
+	}
+	if pieces > 2*logic.Pieces(r) {
+		return nil, errors.New("Too many pieces for " + r.Title())
+	}
+
+	tb := &Tablebase{Rules: r, tables: make(map[material][]byte)}
+	for n := 2; n <= pieces; n++ {
+		positions, err := tb.solve(n)
+		if err != nil {
+			return nil, err
+		}
+		tb.Pieces = n
+		if progress != nil {
+			progress(n, positions)
+		}
+	}
+
+	return tb, nil
+}
+
+// Solves every position with a number of pieces, returns the number of positions.
+// Captures and promotions lead to positions with fewer pieces or fewer men, so the slices with the fewest men are solved first.
+// Besides the finished tables, only the slice being solved is kept in memory
+func (tb *Tablebase) solve(pieces int) (int, error) {
+	var slices [][]material
+	seen := make(map[material]bool)
+	for _, m := range materials(pieces) {
+		if seen[m] {
+			continue
+		}
+		ms := []material{m}
+		if mirror := m.mirror(); mirror != m {
+			ms = append(ms, mirror)
+		}
+		for _, m := range ms {
+			seen[m] = true
+		}
+		slices = append(slices, ms)
+	}
+	sort.SliceStable(slices, func(i, j int) bool {
+		return slices[i][0].Men+slices[i][0].OtherMen < slices[j][0].Men+slices[j][0].OtherMen
+	})
+
+	count := 0
+	for _, ms := range slices {
+		positions, err := tb.solveSlice(ms)
+		if err != nil {
+			return 0, err
+		}
+		count += positions
+	}
+	return count, nil
+}
+
+// Solves the positions of a slice by retrograde analysis, returns the number of positions
+func (tb *Tablebase) solveSlice(ms []material) (int, error) {
+	sl := &slice{Materials: ms}
+	var total uint64
+	for _, m := range ms {
+		x := newIndexer(m, tb.Rules)
+		sl.Indexers = append(sl.Indexers, x)
+		sl.Offsets = append(sl.Offsets, uint32(total))
+		total += x.size()
+	}
+	if total >= 1<<32 {
+		return 0, errors.New("Too many positions with " + strconv.Itoa(ms[0].pieces()) + " pieces")
+	}
+	sl.Values = make([]byte, total)
+	sl.Solved = make([]bool, total)
+	sl.Children = make([]uint16, total)
+
+	// Positions are decided in order of the plies to the end of the game, those with as many plies are decided in the same step
+	steps := make([][]decision, farthest+1)
+	expect := func(id uint32, v Value) error {
+		if v.Plies > farthest {
+			return errors.New("Positions with " + strconv.Itoa(ms[0].pieces()) + " pieces take too long to win")
+		}
+		sl.Values[id] = encode(v)
+		steps[v.Plies] = append(steps[v.Plies], decision{id, encode(v)})
+		return nil
+	}
+
+	// Find what the moves out of every position tell, positions without moves are already decided
+	count := 0
+	for i, x := range sl.Indexers {
+		for index := uint64(0); index < x.size(); index++ {
+			id := sl.Offsets[i] + uint32(index)
+			board, ok := x.board(index, 1)
+			if !ok {
+				sl.Values[id] = invalid
+				sl.Solved[id] = true
+				continue
+			}
+			count++
+
+			game := logic.Game{Turn: 1, Board: board, Variant: tb.Rules.Name()}
+			children, loss, win, ok := tb.successors(&game, sl)
+			var err error
+			switch {
+			case !ok:
+				err = expect(id, tb.blocked())
+			case loss > 0:
+				err = expect(id, Value{Outcome: WIN, Plies: int(loss)})
+			case children == 0 && win >= 0:
+				err = expect(id, Value{Outcome: LOSS, Plies: win + 1})
+			}
+			if err != nil {
+				return 0, err
+			}
+			sl.Children[id] = uint16(children)
+		}
+	}
+
+	// A decided position tells about the positions that lead to it, which are then decided in a later step
+	for plies := range steps {
+		for i := 0; i < len(steps[plies]); i++ {
+			d := steps[plies][i]
+			if sl.Solved[d.ID] || sl.Values[d.ID] != d.Value {
+				continue // A better value was found after this one was expected
+			}
+			sl.Solved[d.ID] = true
+
+			game, _ := sl.game(d.ID, tb.Rules)
+			v := decode(d.Value)
+			for _, parent := range predecessors(&game) {
+				id, ok := sl.id(&parent)
+				if !ok || sl.Solved[id] {
+					continue
+				}
+
+				// A position is won if a move leads to a loss for the other player
+				if v.Outcome == LOSS {
+					if expected := decode(sl.Values[id]); expected.Outcome != WIN || expected.Plies > plies+1 {
+						if err := expect(id, Value{Outcome: WIN, Plies: plies + 1}); err != nil {
+							return 0, err
+						}
+					}
+					continue
+				}
+
+				// A position is lost once every move leads to a win for the other player
+				if v.Outcome != WIN {
+					continue
+				}
+				if sl.Children[id]--; sl.Children[id] != 0 {
+					continue
+				}
+				if _, _, win, _ := tb.successors(&parent, sl); win >= 0 {
+					if err := expect(id, Value{Outcome: LOSS, Plies: max(win, plies) + 1}); err != nil {
+						return 0, err
+					}
+				}
+			}
+		}
+		steps[plies] = nil
+	}
+
+	// Positions that were never decided are draws
+	for id, solved := range sl.Solved {
+		if !solved {
+			sl.Values[id] = draw
+		}
+	}
+	for i, m := range ms {
+		tb.tables[m] = sl.Values[sl.Offsets[i] : sl.Offsets[i]+uint32(sl.Indexers[i].size())]
+	}
+	return count, nil
+}
+
+// Gets the number of a position in a slice, returns false if the position isn't in it
+func (sl *slice) id(game *logic.Game) (uint32, bool) {
+	m := materialOf(game)
+	for i := range sl.Materials {
+		if sl.Materials[i] == m {
+			index, ok := sl.Indexers[i].index(game)
+			return sl.Offsets[i] + uint32(index), ok
+		}
+	}
+	return 0, false
+}
+
+// Gets the position with a number in a slice
+func (sl *slice) game(id uint32, r logic.Rules) (logic.Game, bool) {
+	i := len(sl.Offsets) - 1
+	for id < sl.Offsets[i] {
+		i--
+	}
+	board, ok := sl.Indexers[i].board(uint64(id-sl.Offsets[i]), 1)
+	return logic.Game{Turn: 1, Board: board, Variant: r.Name()}, ok
+}
+
+// Counts the moves out of a position that stay in a slice and finds what is known about the ones that leave it:
+// 1 + the fewest plies to a loss for the other player, 0 if no move leads to one,
+// and the most plies to a win for the other player, -1 if a move that leaves doesn't lead to one.
+// Returns false if the position has no moves
+func (tb *Tablebase) successors(game *logic.Game, sl *slice) (children int, loss byte, win int, ok bool) {
+	sequences := logic.GetSequences(game)
+	for _, seq := range sequences {
+		child := *game
+		logic.ApplySequence(seq, &child)
+		logic.SwapTurn(&child)
+		if _, in := sl.id(&child); in {
+			children++
+			continue
+		}
+
+		// Captures and promotions lead to positions that have already been solved
+		v := tb.lookup(materialOf(&child), &child)
+		if v.Outcome == LOSS && (loss == 0 || byte(v.Plies)+1 < loss) {
+			loss = byte(v.Plies) + 1
+		}
+		if v.Outcome != WIN {
+			win = -1
+		} else if win >= 0 && v.Plies > win {
+			win = v.Plies
+		}
+	}
+	return children, loss, win, len(sequences) != 0
+}
+
+// Finds the positions a move without a capture or a promotion leads to a position from, with the turn of the player who made it
+func predecessors(game *logic.Game) []logic.Game {
+	// The player who moved last is the one whose turn it isn't
+	moved := *game
+	logic.SwapTurn(&moved)
+
+	var found []logic.Game
+	for i := range moved.Board {
+		to, err := logic.SquareAtIndex(uint8(i), &moved)
+		if err != nil || to.Player() != moved.Turn {
+			continue
+		}
+		for _, dir := range logic.Directions {
+			// Men only move north, so they came from the south
+			if !to.IsKing() && dir.Y < 0 {
+				continue
+			}
+
+			for current := to; ; {
+				from, err := current.SquareAtDirection(dir, &moved)
+				if err != nil || !from.IsEmpty() {
+					break
+				}
+
+				board := []byte(moved.Board)
+				board[from.Index], board[to.Index] = board[to.Index], '0'
+				parent := moved
+				parent.Board = string(board)
+				if canMove(&parent) {
+					found = append(found, parent)
+				}
+
+				if !to.IsKing() || !moved.Rules().FlyingKings() {
+					break
+				}
+				current = from
+			}
+		}
+	}
+	return found
+}
+
+// Checks if a move without a capture can be made, the path of the move is clear so only a capture that has to be made rules it out
+func canMove(game *logic.Game) bool {
+	return !game.Rules().MandatoryCapture() || len(logic.GetCaptures(game)) == 0
+}
+
+// Gets the value of a position that has already been solved
+func (tb *Tablebase) lookup(m material, game *logic.Game) Value {
+	// A player without pieces can't move
+	if m.Men+m.Kings == 0 {
+		return tb.blocked()
+	}
+
+	index, _ := newIndexer(m, tb.Rules).index(game)
+	return decode(tb.tables[m][index])
+}
+
+// Gets the value of a position where the player whose turn it is can't move
+func (tb *Tablebase) blocked() Value {
+	if tb.Rules.Inverted() {
+		return Value{Outcome: WIN}
+	}
+	return Value{Outcome: LOSS}
+}
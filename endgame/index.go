@@ -0,0 +1,183 @@
+package endgame
+
+import (
+	"strings"
+
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// The pieces each player has in a position, from the point of view of the player whose turn it is
+type material struct {
+	Men        uint8 // Men of the player whose turn it is
+	Kings      uint8 // Kings of the player whose turn it is
+	OtherMen   uint8 // Men of the other player
+	OtherKings uint8 // Kings of the other player
+}
+
+// Gets the total number of pieces on the board
+func (m material) pieces() int {
+	return int(m.Men) + int(m.Kings) + int(m.OtherMen) + int(m.OtherKings)
+}
+
+// Gets the material from the point of view of the other player
+func (m material) mirror() material {
+	return material{m.OtherMen, m.OtherKings, m.Men, m.Kings}
+}
+
+// Gets the material of a position
+func materialOf(game *logic.Game) material {
+	var m material
+	for i := range game.Board {
+		switch game.Board[i] - '0' {
+		case game.Turn:
+			m.Men++
+		case game.Turn + 2:
+			m.Kings++
+		case 3 - game.Turn:
+			m.OtherMen++
+		case 5 - game.Turn:
+			m.OtherKings++
+		}
+	}
+
+	return m
+}
+
+// Lists every material with the given number of pieces where the player whose turn it is has at least one piece, the other player can have none
+func materials(pieces int) []material {
+	var list []material
+	for men := 0; men < pieces; men++ {
+		for kings := 0; men+kings < pieces; kings++ {
+			if men+kings == 0 {
+				continue
+			}
+			for otherMen := 0; men+kings+otherMen <= pieces; otherMen++ {
+				otherKings := pieces - men - kings - otherMen
+				list = append(list, material{uint8(men), uint8(kings), uint8(otherMen), uint8(otherKings)})
+			}
+		}
+	}
+
+	return list
+}
+
+// Gets the number of ways to choose k of n items
+func choose(n int, k int) uint64 {
+	if k < 0 || k > n {
+		return 0
+	}
+
+	result := uint64(1)
+	for i := 1; i <= k; i++ {
+		result = result * uint64(n-k+i) / uint64(i)
+	}
+	return result
+}
+
+// Ranks a sorted combination of squares
+func rank(squares []int) uint64 {
+	var r uint64
+	for i, s := range squares {
+		r += choose(s, i+1)
+	}
+
+	return r
+}
+
+// Gets the sorted combination of k squares with the given rank
+func unrank(r uint64, k int, squares []int) []int {
+	squares = squares[:k]
+	for i := k; i > 0; i-- {
+		s := i - 1
+		for choose(s+1, i) <= r {
+			s++
+		}
+		squares[i-1] = s
+		r -= choose(s, i)
+	}
+
+	return squares
+}
+
+// Numbers every position with a given material.
+// Men of the player whose turn it is can't be on the first row as they would have been crowned, the other players men can't be on the last row.
+// Each group of pieces is ranked on its own, positions where pieces overlap are skipped
+type indexer struct {
+	Material material
+	Squares  int // Number of playable squares
+	Width    int // Number of playable squares in each row
+	sizes    [4]uint64
+}
+
+// Creates the indexer for a material
+func newIndexer(m material, r logic.Rules) indexer {
+	width := int(logic.Width(r))
+	squares := int(r.Size()) * width
+	return indexer{
+		Material: m,
+		Squares:  squares,
+		Width:    width,
+		sizes: [4]uint64{
+			choose(squares-width, int(m.Men)),
+			choose(squares-width, int(m.OtherMen)),
+			choose(squares, int(m.Kings)),
+			choose(squares, int(m.OtherKings)),
+		},
+	}
+}
+
+// Gets the number of indices
+func (x indexer) size() uint64 {
+	return x.sizes[0] * x.sizes[1] * x.sizes[2] * x.sizes[3]
+}
+
+// Gets the index of a position, returns false if a man is on a row it can't be on
+func (x indexer) index(game *logic.Game) (uint64, bool) {
+	var groups [4][]int
+	for i := range game.Board {
+		switch game.Board[i] - '0' {
+		case game.Turn:
+			if i < x.Width {
+				return 0, false
+			}
+			groups[0] = append(groups[0], i-x.Width)
+		case 3 - game.Turn:
+			if i >= x.Squares-x.Width {
+				return 0, false
+			}
+			groups[1] = append(groups[1], i)
+		case game.Turn + 2:
+			groups[2] = append(groups[2], i)
+		case 5 - game.Turn:
+			groups[3] = append(groups[3], i)
+		}
+	}
+
+	var index uint64
+	for i, g := range groups {
+		index = index*x.sizes[i] + rank(g)
+	}
+	return index, true
+}
+
+// Gets the board of the position at an index, returns false if pieces overlap
+func (x indexer) board(index uint64, turn uint8) (string, bool) {
+	board := []byte(strings.Repeat("0", x.Squares))
+	counts := [4]int{int(x.Material.Men), int(x.Material.OtherMen), int(x.Material.Kings), int(x.Material.OtherKings)}
+	pieces := [4]byte{'0' + turn, '0' + 3 - turn, '0' + turn + 2, '0' + 5 - turn}
+	offsets := [4]int{x.Width, 0, 0, 0}
+	buffer := make([]int, x.Squares)
+
+	for i := 3; i >= 0; i-- {
+		r := index % x.sizes[i]
+		index /= x.sizes[i]
+		for _, s := range unrank(r, counts[i], buffer) {
+			if board[s+offsets[i]] != '0' {
+				return "", false
+			}
+			board[s+offsets[i]] = pieces[i]
+		}
+	}
+
+	return string(board), true
+}
@@ -0,0 +1,255 @@
+package endgame
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// The result of a position with perfect play
+type Outcome int8
+
+// Outcome Enum, from the point of view of the player whose turn it is
+const (
+	LOSS Outcome = -1
+	DRAW Outcome = 0
+	WIN  Outcome = 1
+)
+
+// The file extension used for saved tablebases
+const EXTENSION = ".tb"
+
+// Written at the start of every tablebase file
+const magic = "CKTB"
+
+// Version of the file format
+const version uint8 = 1
+
+// Values are stored in a single byte each
+const (
+	draw     byte = 0   // Neither player can force a win
+	wins     byte = 1   // Wins are stored as 1 + the number of plies to the win
+	losses   byte = 128 // Losses are stored as 128 + the number of plies to the loss
+	invalid  byte = 255 // Positions that can't happen
+	farthest      = 126 // The most plies that can be stored
+)
+
+// The value of a position in a tablebase
+type Value struct {
+	Outcome Outcome // The result for the player whose turn it is
+	Plies   int     // Number of plies until the game is won or lost with perfect play, 0 for draws
+}
+
+// Encodes a value into a byte
+func encode(v Value) byte {
+	switch v.Outcome {
+	case WIN:
+		return wins + byte(v.Plies)
+	case LOSS:
+		return losses + byte(v.Plies)
+	}
+	return draw
+}
+
+// Decodes a value from a byte
+func decode(b byte) Value {
+	if b >= losses {
+		return Value{Outcome: LOSS, Plies: int(b - losses)}
+	} else if b >= wins {
+		return Value{Outcome: WIN, Plies: int(b - wins)}
+	}
+	return Value{Outcome: DRAW}
+}
+
+// Endgame database with the value of every position of a variant with up to a number of pieces
+type Tablebase struct {
+	Rules  logic.Rules // The variant the positions are played in
+	Pieces int         // The most pieces on the board in a position the tablebase knows
+
+	tables map[material][]byte
+}
+
+// Gets the value of a position, returns false if the position isn't in the tablebase
+func (tb *Tablebase) Probe(game *logic.Game) (Value, bool) {
+	if game.Rules().Name() != tb.Rules.Name() || logic.IsMultiJump(game) {
+		return Value{}, false
+	}
+
+	m := materialOf(game)
+	table, ok := tb.tables[m]
+	if !ok {
+		return Value{}, false
+	}
+
+	index, ok := newIndexer(m, tb.Rules).index(game)
+	if !ok || table[index] == invalid {
+		return Value{}, false
+	}
+	return decode(table[index]), true
+}
+
+// Gets the number of positions in the tablebase
+func (tb *Tablebase) Positions() int {
+	positions := 0
+	for _, table := range tb.tables {
+		for _, b := range table {
+			if b != invalid {
+				positions++
+			}
+		}
+	}
+
+	return positions
+}
+
+// Writes the tablebase to a file.
+// The file is gzipped and holds a header with the variant and number of pieces followed by each material and its values
+func (tb *Tablebase) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	z := gzip.NewWriter(f)
+	w := bufio.NewWriter(z)
+	name := tb.Rules.Name()
+	w.WriteString(magic)
+	w.WriteByte(version)
+	w.WriteByte(byte(len(name)))
+	w.WriteString(name)
+	w.WriteByte(byte(tb.Pieces))
+	binary.Write(w, binary.BigEndian, uint16(len(tb.tables)))
+
+	for pieces := 2; pieces <= tb.Pieces; pieces++ {
+		for _, m := range materials(pieces) {
+			table, ok := tb.tables[m]
+			if !ok {
+				continue
+			}
+			w.Write([]byte{m.Men, m.Kings, m.OtherMen, m.OtherKings})
+			binary.Write(w, binary.BigEndian, uint64(len(table)))
+			w.Write(table)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := z.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// Reads a tablebase from a file written by Save
+func Load(path string) (*Tablebase, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	z, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, errors.New("Not a tablebase file")
+	}
+	r := bufio.NewReader(z)
+
+	header := make([]byte, len(magic)+2)
+	if _, err := io.ReadFull(r, header); err != nil || string(header[:len(magic)]) != magic {
+		return nil, errors.New("Not a tablebase file")
+	}
+	if header[len(magic)] != version {
+		return nil, errors.New("Unsupported tablebase version")
+	}
+
+	name := make([]byte, header[len(magic)+1])
+	if _, err := io.ReadFull(r, name); err != nil {
+		return nil, errors.New("Corrupt tablebase file")
+	}
+	rules, err := logic.GetRules(string(name))
+	if err != nil {
+		return nil, err
+	}
+
+	pieces, err := r.ReadByte()
+	if err != nil {
+		return nil, errors.New("Corrupt tablebase file")
+	}
+	var count uint16
+	if err := binary.Read(r, binary.BigEndian, &count); err != nil {
+		return nil, errors.New("Corrupt tablebase file")
+	}
+
+	tb := &Tablebase{Rules: rules, Pieces: int(pieces), tables: make(map[material][]byte)}
+	for i := 0; i < int(count); i++ {
+		var m material
+		var length uint64
+		if err := binary.Read(r, binary.BigEndian, &m); err != nil {
+			return nil, errors.New("Corrupt tablebase file")
+		}
+		if err := binary.Read(r, binary.BigEndian, &length); err != nil || length != newIndexer(m, rules).size() {
+			return nil, errors.New("Corrupt tablebase file")
+		}
+
+		table := make([]byte, length)
+		if _, err := io.ReadFull(r, table); err != nil {
+			return nil, errors.New("Corrupt tablebase file")
+		}
+		tb.tables[m] = table
+	}
+
+	return tb, nil
+}
+
+// Tablebases for several variants
+type Databases map[string]*Tablebase
+
+// Loads every tablebase file in a directory
+func LoadDir(dir string) (Databases, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	databases := make(Databases)
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), EXTENSION) {
+			continue
+		}
+
+		tb, err := Load(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, errors.New(f.Name() + ": " + err.Error())
+		}
+		databases[tb.Rules.Name()] = tb
+	}
+
+	return databases, nil
+}
+
+// Gets the value of a position from the tablebase for its variant, returns false if the position isn't in any of them
+func (d Databases) Probe(game *logic.Game) (Value, bool) {
+	tb, ok := d[game.Rules().Name()]
+	if !ok {
+		return Value{}, false
+	}
+	return tb.Probe(game)
+}
+
+// Gets the most pieces a position of the variant can have to be in a tablebase, 0 if there is none
+func (d Databases) Pieces(r logic.Rules) int {
+	if tb, ok := d[r.Name()]; ok {
+		return tb.Pieces
+	}
+	return 0
+}
@@ -0,0 +1,114 @@
+package endgame
+
+import (
+	"testing"
+
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// The 3 piece tablebases generated by the tests, by variant
+var generated = make(map[string]*Tablebase)
+
+// Generates the 3 piece tablebase of a variant once, skipped in short mode since it takes a few seconds
+func generate(t *testing.T, r logic.Rules) *Tablebase {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("Generating tablebases is slow")
+	}
+
+	if tb, ok := generated[r.Name()]; ok {
+		return tb
+	}
+	tb, err := Generate(r, 3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated[r.Name()] = tb
+	return tb
+}
+
+// Known 2 and 3 piece endgames have the right result
+func TestTablebase(t *testing.T) {
+	tests := []struct {
+		name    string
+		variant string
+		fen     string
+		want    Outcome
+		plies   int // Checked when it isn't -1
+	}{
+		{"king against king", "american", "W:WK1:BK32", DRAW, 0},
+		{"capturing the last piece", "american", "W:WK14:BK10", WIN, 1},
+		{"being captured", "american", "B:WK14:BK10", WIN, 1},
+		{"two kings against one", "american", "W:WK1,K5:BK32", WIN, -1},
+		{"one king against two", "american", "W:WK5:BK1,K32", LOSS, -1},
+		{"two kings against one in a corner", "american", "W:WK1,K32:BK10", WIN, -1},
+		{"capturing the last piece in giveaway", "giveaway", "W:WK14:BK10", LOSS, 1},
+		{"king against king in giveaway", "giveaway", "W:WK1:BK32", LOSS, -1},
+	}
+
+	for _, test := range tests {
+		r, err := logic.GetRules(test.variant)
+		if err != nil {
+			t.Fatal(err)
+		}
+		game, err := logic.ParseFEN(test.fen, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		v, ok := generate(t, r).Probe(&game)
+		if !ok {
+			t.Errorf("%s: %s isn't in the tablebase", test.name, test.fen)
+		} else if v.Outcome != test.want || (test.plies != -1 && v.Plies != test.plies) {
+			t.Errorf("%s: %s is %+v, want %d in %d plies", test.name, test.fen, v, test.want, test.plies)
+		}
+	}
+}
+
+// The value of every position follows from the values of the positions its moves lead to
+func TestTablebaseConsistent(t *testing.T) {
+	r := logic.American{}
+	tb := generate(t, r)
+
+	for m, table := range tb.tables {
+		x := newIndexer(m, r)
+		for index := range table {
+			board, ok := x.board(uint64(index), 1)
+			if !ok {
+				continue
+			}
+			game := logic.Game{Turn: 1, Board: board, Variant: r.Name()}
+			v, _ := tb.Probe(&game)
+
+			// The best move for the player whose turn it is, a win as fast as possible or a loss as slow as possible
+			best := tb.blocked()
+			for i, seq := range logic.GetSequences(&game) {
+				child := game
+				logic.ApplySequence(seq, &child)
+				logic.SwapTurn(&child)
+				c := tb.lookup(materialOf(&child), &child)
+				result := Value{Outcome: -c.Outcome}
+				if c.Outcome != DRAW {
+					result.Plies = c.Plies + 1
+				}
+				if i == 0 || better(result, best) {
+					best = result
+				}
+			}
+			if v != best {
+				t.Fatalf("%s is %+v but its best move gives %+v", logic.FormatFEN(&game), v, best)
+			}
+		}
+	}
+}
+
+// Checks if a value is better than another for the player whose turn it is
+func better(a Value, b Value) bool {
+	if a.Outcome != b.Outcome {
+		return a.Outcome > b.Outcome
+	}
+	if a.Outcome == WIN {
+		return a.Plies < b.Plies
+	}
+	return a.Outcome == LOSS && a.Plies > b.Plies
+}
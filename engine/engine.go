@@ -0,0 +1,183 @@
+package engine
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/endgame"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Returned when the player to move has no moves to search
+var ErrNoMoves = errors.New("No moves available")
+
+// Settings for how the engine searches
+type Config struct {
+	Depth int           // Maximum depth to search in plies
+	Time  time.Duration // Maximum time to search for, 0 for no limit
+	Book  Book          // Opening book to play the first moves from, nil to always search
+
+	Tablebase Tablebase // Endgame database to look up positions with few pieces in, nil to always search
+}
+
+// The default settings used by the bot
+var DEFAULT Config = Config{Depth: 8, Time: 2 * time.Second}
+
+// An opening book the engine can play from
+type Book interface {
+	Lookup(game *logic.Game) (logic.Sequence, bool) // Gets a move to play in the position if the book has one
+}
+
+// An endgame database the engine can look up exact results in
+type Tablebase interface {
+	Probe(game *logic.Game) (endgame.Value, bool) // Gets the value of a position if the database has it
+}
+
+// The result of a search
+type Result struct {
+	Move  logic.Sequence   // The best move found
+	Score int              // Score of the move for the player whose turn it is
+	Depth int              // Depth of the last completed search
+	Nodes uint64           // Number of positions searched
+	PV    []logic.Sequence // The principal variation, starting with the best move
+	Book  bool             // If the move was taken from the opening book
+}
+
+// Positions searched and nanoseconds spent searching by every engine, for monitoring
+var searchedNodes, searchedTime uint64
+
+// Gets the number of positions searched and the time spent searching by every engine since the program started
+func Searched() (uint64, time.Duration) {
+	return atomic.LoadUint64(&searchedNodes), time.Duration(atomic.LoadUint64(&searchedTime))
+}
+
+// Searches positions for the best move
+type Engine struct {
+	Config Config
+
+	nodes    uint64
+	deadline time.Time
+	aborted  bool
+	table    map[string]entry
+}
+
+// Creates an engine with the given settings
+func New(c Config) *Engine {
+	if c.Depth <= 0 {
+		c.Depth = DEFAULT.Depth
+	}
+	return &Engine{Config: c}
+}
+
+// Gets the best move for the player whose turn it is
+func (e *Engine) BestMove(game logic.Game) (Result, error) {
+	if e.Config.Book != nil {
+		if move, ok := e.Config.Book.Lookup(&game); ok {
+			return Result{Move: move, PV: []logic.Sequence{move}, Book: true}, nil
+		}
+	}
+
+	results, err := e.Analyze(game, 1)
+	if err != nil {
+		return Result{}, err
+	}
+	return results[0], nil
+}
+
+// Searches every move and gets the best lines, sorted from best to worst
+func (e *Engine) Analyze(game logic.Game, lines int) ([]Result, error) {
+	moves := logic.GetSequences(&game)
+	if len(moves) == 0 {
+		return nil, ErrNoMoves
+	}
+	if lines <= 0 || lines > len(moves) {
+		lines = len(moves)
+	}
+
+	start := time.Now()
+	e.nodes = 0
+	e.aborted = false
+	e.table = make(map[string]entry)
+	if e.Config.Time > 0 {
+		e.deadline = time.Now().Add(e.Config.Time)
+	} else {
+		e.deadline = time.Time{}
+	}
+
+	var results []Result
+	for depth := 1; depth <= e.Config.Depth; depth++ {
+		iteration := e.searchRoot(game, moves, depth, lines)
+		if e.aborted {
+			break
+		}
+		results = iteration
+
+		// Search the best moves first in the next iteration
+		moves = moves[:0]
+		for _, r := range results {
+			moves = append(moves, r.Move)
+		}
+		moves = append(moves, remaining(logic.GetSequences(&game), moves)...)
+
+		// No point searching deeper once a forced win or loss is found
+		if abs(results[0].Score) > WIN-1000 {
+			break
+		}
+	}
+
+	// If even the first iteration didn't finish just play the first move
+	if results == nil {
+		results = []Result{{Move: moves[0], PV: []logic.Sequence{moves[0]}}}
+	}
+	for i := range results {
+		results[i].Nodes = e.nodes
+	}
+	atomic.AddUint64(&searchedNodes, e.nodes)
+	atomic.AddUint64(&searchedTime, uint64(time.Since(start)))
+	if len(results) > lines {
+		results = results[:lines]
+	}
+
+	return results, nil
+}
+
+// Gets the moves that aren't already in the list
+func remaining(all []logic.Sequence, moves []logic.Sequence) []logic.Sequence {
+	var rest []logic.Sequence
+	for _, m := range all {
+		found := false
+		for _, n := range moves {
+			if sameSequence(m, n) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			rest = append(rest, m)
+		}
+	}
+
+	return rest
+}
+
+// Checks if two sequences are the same
+func sameSequence(a logic.Sequence, b logic.Sequence) bool {
+	if a.From.Index != b.From.Index || len(a.Steps) != len(b.Steps) {
+		return false
+	}
+	for i := range a.Steps {
+		if a.Steps[i].S.Index != b.Steps[i].S.Index || a.Steps[i].Jumped.Index != b.Steps[i].Jumped.Index {
+			return false
+		}
+	}
+
+	return true
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
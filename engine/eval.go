@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Values used to score positions
+const (
+	WIN         = 100000 // Score of a won position, reduced by the number of plies it takes to win
+	MAN         = 100    // Value of a man
+	KING        = 160    // Value of a king
+	FLYING_KING = 300    // Value of a king that can move any distance
+	ADVANCE     = 4      // Bonus for each row a man has advanced
+	CENTER      = 6      // Bonus for a piece in the middle of the board
+	BACK_ROW    = 10     // Bonus for a man guarding the back row
+)
+
+// Scores a position for the player whose turn it is
+func Evaluate(game *logic.Game) int {
+	r := game.Rules()
+	size := int(r.Size())
+	width := int(logic.Width(r))
+	king := KING
+	if r.FlyingKings() {
+		king = FLYING_KING
+	}
+
+	score := 0
+	for i := 0; i < len(game.Board); i++ {
+		piece := game.Board[i] - '0'
+		if piece == 0 || piece == logic.CAPTURED {
+			continue
+		}
+
+		y := i / width
+		x := i % width
+		value := 0
+		if piece > 2 {
+			value = king
+		} else {
+			value = MAN
+			// Men of the player whose turn it is move north
+			if piece == game.Turn {
+				value += (size - 1 - y) * ADVANCE
+				if y == size-1 {
+					value += BACK_ROW
+				}
+			} else {
+				value += y * ADVANCE
+				if y == 0 {
+					value += BACK_ROW
+				}
+			}
+		}
+		if x > 0 && x < width-1 && y > 1 && y < size-2 {
+			value += CENTER
+		}
+
+		if piece == game.Turn || piece == game.Turn+2 {
+			score += value
+		} else {
+			score -= value
+		}
+	}
+
+	// In inverted variants having less pieces is better
+	if r.Inverted() {
+		return -score
+	}
+	return score
+}
@@ -0,0 +1,113 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// How much worse a move was than the best move
+type Judgement uint8
+
+// Judgement Enum
+const (
+	GOOD Judgement = iota
+	INACCURACY
+	MISTAKE
+	BLUNDER
+)
+
+// The least a move has to lose for each judgement, in the same units as scores
+const (
+	INACCURACY_LOSS = 50
+	MISTAKE_LOSS    = 100
+	BLUNDER_LOSS    = 250
+)
+
+// Scores are capped when comparing moves so a slower win isn't called a mistake
+const REVIEW_CAP = 1000
+
+// The engine's opinion on a move of a game
+type Annotation struct {
+	Move      logic.Sequence // The move that was played
+	Player    uint8          // The player who made the move
+	Before    int            // Score of the position before the move for the player who made it
+	After     int            // Score of the position after the move for the player who made it
+	Best      logic.Sequence // The best move the engine found
+	Judgement Judgement      // How bad the move was
+}
+
+// Searches every position of a game and judges each move, the game is played from the start position
+func (e *Engine) Review(start logic.Game, moves []logic.Sequence) ([]Annotation, error) {
+	// Score every position including the last one
+	var scores []int
+	var best []logic.Sequence
+	game := start
+	for i := 0; i <= len(moves); i++ {
+		if logic.IsMultiJump(&game) {
+			return nil, errors.New("Moves have to be whole sequences")
+		}
+
+		if !logic.HasMoves(&game) {
+			if game.Rules().Inverted() {
+				scores = append(scores, WIN)
+			} else {
+				scores = append(scores, -WIN)
+			}
+			best = append(best, logic.Sequence{})
+		} else {
+			results, err := e.Analyze(game, 1)
+			if err != nil {
+				return nil, err
+			}
+			scores = append(scores, results[0].Score)
+			best = append(best, results[0].Move)
+		}
+
+		if i < len(moves) {
+			logic.ApplySequence(moves[i], &game)
+			logic.SwapTurn(&game)
+		}
+	}
+
+	var annotations []Annotation
+	game = start
+	for i, move := range moves {
+		a := Annotation{
+			Move:   move,
+			Player: game.Turn,
+			Before: scores[i],
+			After:  -scores[i+1],
+			Best:   best[i],
+		}
+
+		loss := capScore(a.Before) - capScore(a.After)
+		if sameSequence(move, best[i]) {
+			loss = 0
+		}
+		switch {
+		case loss >= BLUNDER_LOSS:
+			a.Judgement = BLUNDER
+		case loss >= MISTAKE_LOSS:
+			a.Judgement = MISTAKE
+		case loss >= INACCURACY_LOSS:
+			a.Judgement = INACCURACY
+		}
+		annotations = append(annotations, a)
+
+		logic.ApplySequence(move, &game)
+		logic.SwapTurn(&game)
+	}
+
+	return annotations, nil
+}
+
+// Keeps a score between the review caps
+func capScore(score int) int {
+	if score > REVIEW_CAP {
+		return REVIEW_CAP
+	} else if score < -REVIEW_CAP {
+		return -REVIEW_CAP
+	}
+	return score
+}
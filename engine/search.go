@@ -0,0 +1,174 @@
+package engine
+
+import (
+	"sort"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/endgame"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Bounds stored in the transposition table
+const (
+	EXACT uint8 = iota
+	LOWER
+	UPPER
+)
+
+// The furthest the search can go when it keeps searching jumps
+const MAX_PLY = 64
+
+// A position that has already been searched
+type entry struct {
+	Depth int
+	Score int
+	Bound uint8
+	Best  logic.Sequence
+}
+
+// Gets the key of a position in the transposition table
+func key(game *logic.Game) string {
+	return string('0'+game.Turn) + string('0'+game.Selected) + game.Board
+}
+
+// Searches every move at the root and scores them
+func (e *Engine) searchRoot(game logic.Game, moves []logic.Sequence, depth int, lines int) []Result {
+	var results []Result
+	alpha := -WIN - 1
+
+	for _, move := range moves {
+		child := game
+		logic.ApplySequence(move, &child)
+		logic.SwapTurn(&child)
+
+		// Every move needs an exact score when looking for more than one line
+		var score int
+		var pv []logic.Sequence
+		if lines > 1 {
+			score, pv = e.negamax(child, depth-1, -WIN-1, WIN+1, 1)
+		} else {
+			score, pv = e.negamax(child, depth-1, -WIN-1, -alpha, 1)
+		}
+		score = -score
+		if e.aborted {
+			return nil
+		}
+
+		if score > alpha {
+			alpha = score
+		}
+		results = append(results, Result{
+			Move:  move,
+			Score: score,
+			Depth: depth,
+			PV:    append([]logic.Sequence{move}, pv...),
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results
+}
+
+// Searches a position with alpha beta pruning, returns the score and the principal variation
+func (e *Engine) negamax(game logic.Game, depth int, alpha int, beta int, ply int) (int, []logic.Sequence) {
+	e.nodes++
+	if e.nodes%1024 == 0 && !e.deadline.IsZero() && time.Now().After(e.deadline) {
+		e.aborted = true
+	}
+	if e.aborted {
+		return 0, nil
+	}
+
+	// Positions in the endgame database don't need to be searched
+	if e.Config.Tablebase != nil {
+		if v, ok := e.Config.Tablebase.Probe(&game); ok {
+			return tablebaseScore(v, ply), nil
+		}
+	}
+
+	moves := logic.GetSequences(&game)
+	if len(moves) == 0 {
+		// The player who can't move loses, or wins in inverted variants
+		if game.Rules().Inverted() {
+			return WIN - ply, nil
+		}
+		return -(WIN - ply), nil
+	}
+
+	// Keep searching while there are jumps that have to be made so the score isn't thrown off by a trade
+	if depth <= 0 {
+		forced := moves[0].IsCapture() && game.Rules().MandatoryCapture()
+		if !forced || ply >= MAX_PLY {
+			return Evaluate(&game), nil
+		}
+		depth = 0
+	}
+
+	// Check if the position has already been searched
+	k := key(&game)
+	if t, ok := e.table[k]; ok {
+		if t.Depth >= depth {
+			if t.Bound == EXACT || (t.Bound == LOWER && t.Score >= beta) || (t.Bound == UPPER && t.Score <= alpha) {
+				return t.Score, []logic.Sequence{t.Best}
+			}
+		}
+		// Search the best move from last time first
+		for i, m := range moves {
+			if sameSequence(m, t.Best) {
+				moves[0], moves[i] = moves[i], moves[0]
+				break
+			}
+		}
+	}
+
+	original := alpha
+	best := -WIN - 1
+	var bestMove logic.Sequence
+	var bestPV []logic.Sequence
+	for _, move := range moves {
+		child := game
+		logic.ApplySequence(move, &child)
+		logic.SwapTurn(&child)
+
+		score, pv := e.negamax(child, depth-1, -beta, -alpha, ply+1)
+		score = -score
+		if e.aborted {
+			return 0, nil
+		}
+
+		if score > best {
+			best = score
+			bestMove = move
+			bestPV = append([]logic.Sequence{move}, pv...)
+		}
+		if score > alpha {
+			alpha = score
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+
+	bound := EXACT
+	if best <= original {
+		bound = UPPER
+	} else if best >= beta {
+		bound = LOWER
+	}
+	e.table[k] = entry{Depth: depth, Score: best, Bound: bound, Best: bestMove}
+
+	return best, bestPV
+}
+
+// Converts a value from the endgame database into a score so faster wins score higher
+func tablebaseScore(v endgame.Value, ply int) int {
+	switch v.Outcome {
+	case endgame.WIN:
+		return WIN - (ply + v.Plies)
+	case endgame.LOSS:
+		return -(WIN - (ply + v.Plies))
+	}
+	return 0
+}
@@ -0,0 +1,104 @@
+package games
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// How often games are checked for a player who ran out of time
+const CLOCK_CHECK = 5 * time.Second
+
+// The time each player gets for the whole game, the zero value is a game without a time limit
+type TimeControl struct {
+	Base      time.Duration // Time each player starts with
+	Increment time.Duration // Time added after every move
+}
+
+// Parses a time control written as minutes+increment in seconds like 10+5, or off for no time limit
+func ParseTimeControl(s string) (TimeControl, error) {
+	if s == "" || s == "off" || s == "-" {
+		return TimeControl{}, nil
+	}
+
+	values := strings.Split(s, "+")
+	if len(values) > 2 {
+		return TimeControl{}, errors.New("Invalid time control, use minutes+increment like 10+5")
+	}
+	minutes, err := strconv.Atoi(values[0])
+	if err != nil || minutes <= 0 {
+		return TimeControl{}, errors.New("Invalid time control, use minutes+increment like 10+5")
+	}
+	c := TimeControl{Base: time.Duration(minutes) * time.Minute}
+	if len(values) == 2 {
+		seconds, err := strconv.Atoi(values[1])
+		if err != nil || seconds < 0 {
+			return TimeControl{}, errors.New("Invalid time control, use minutes+increment like 10+5")
+		}
+		c.Increment = time.Duration(seconds) * time.Second
+	}
+	return c, nil
+}
+
+// Checks if the games have a time limit
+func (c TimeControl) Timed() bool {
+	return c.Base > 0
+}
+
+// Writes the time control the way it is parsed, - for no time limit
+func (c TimeControl) String() string {
+	if !c.Timed() {
+		return "-"
+	}
+	return strconv.Itoa(int(c.Base/time.Minute)) + "+" + strconv.Itoa(int(c.Increment/time.Second))
+}
+
+// Gets the time a player has left in a timed game, increments are already counted in the clocks
+func (g Game) Remaining(player uint8, now time.Time) time.Duration {
+	left := g.TimeControl.Base - g.Clock(player, now)
+	if left < 0 {
+		return 0
+	}
+	return left
+}
+
+// Checks if the player to move ran out of time
+func (g Game) outOfTime(now time.Time) bool {
+	return g.TimeControl.Timed() && !g.Over() && g.Clock(g.Turn, now) > g.TimeControl.Base
+}
+
+// Ends a game if the player to move ran out of time, returns false if they still have time
+func (s *Service) Flag(id string) (Game, bool) {
+	g, err := s.Store.update(id, func(g *Game) error {
+		now := time.Now()
+		if !g.outOfTime(now) {
+			return errInTime
+		}
+		g.Clocks[g.Turn] = g.TimeControl.Base
+		g.Started = now
+		g.Winner = 3 - g.Turn
+		g.TimedOut = true
+		return nil
+	})
+	if err != nil {
+		return g, false
+	}
+
+	s.Bus.Publish(GameWon{Context: Context{Game: g, Source: SOURCE_CLOCK}, Winner: g.Winner, Reason: TIMEOUT})
+	return g, true
+}
+
+// Ends the games of players who ran out of time, even if they never try to move again
+func (s *Service) WatchClocks() {
+	go func() {
+		for range time.Tick(CLOCK_CHECK) {
+			now := time.Now()
+			for _, g := range s.Store.Timed() {
+				if g.outOfTime(now) {
+					s.Flag(g.ID)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,146 @@
+package games
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+// Why a game was won
+const (
+	NO_MOVES  = "no_moves"  // The player to move is blocked
+	NO_PIECES = "no_pieces" // The player to move has no pieces left
+	RESIGNED  = "resigned"  // The loser resigned
+	TIMEOUT   = "timeout"   // The loser ran out of time
+)
+
+// What every event has in common
+type Context struct {
+	Game   Game        // The game after the event, games that aren't saved have no ID
+	Source string      // Where the change was made
+	Origin interface{} // Given by whoever made the change and passed along, like the Discord message a reaction was on
+}
+
+// Gets what the event has in common with every other event
+func (c Context) context() Context {
+	return c
+}
+
+// Something that happened in a game, one of the types below
+type Event interface {
+	context() Context
+}
+
+// A game was created
+type GameStarted struct {
+	Context
+}
+
+// A whole move was made, the turn has already passed to the other player
+type MoveApplied struct {
+	Context
+	Player uint8  // The player who made the move
+	Move   string // The move in standard notation
+}
+
+// A man was crowned
+type Promoted struct {
+	Context
+	Player uint8 // The player whose man was crowned
+	Square int   // Number of the square the new king is on
+}
+
+// A jump was made and the piece has to keep jumping, the board of the game still has the jumped pieces on it
+type MultiJumpPending struct {
+	Context
+	Player uint8 // The player who is jumping
+	Index  uint8 // Index of the jumping piece on the board
+}
+
+// A game ended
+type GameWon struct {
+	Context
+	Winner uint8  // The player who won
+	Reason string // Why the game ended
+}
+
+// The other player can move now
+type TurnChanged struct {
+	Context
+	Turn uint8 // The player who can move now
+}
+
+// Sends events to every subscriber
+type Bus struct {
+	mu          sync.Mutex
+	subscribers []func(Event)
+}
+
+// Adds a function called with every event, in the order they happen and on the goroutine that caused them.
+// It holds up whoever made the change, so anything that waits on other services should use SubscribeAsync instead
+func (b *Bus) Subscribe(f func(Event)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers = append(b.subscribers, f)
+}
+
+// Adds a function called with every event, in the order they happen but on a goroutine of its own so it never holds up the change
+func (b *Bus) SubscribeAsync(f func(Event)) {
+	q := &eventQueue{wake: make(chan struct{}, 1)}
+	go q.run(f)
+	b.Subscribe(q.push)
+}
+
+// Sends an event to every subscriber, a subscriber that panics is logged and doesn't stop the others
+func (b *Bus) Publish(e Event) {
+	b.mu.Lock()
+	subscribers := append([]func(Event){}, b.subscribers...)
+	b.mu.Unlock()
+
+	for _, f := range subscribers {
+		call(f, e)
+	}
+}
+
+// Calls a subscriber with an event, recovering if it panics
+func call(f func(Event), e Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("Event subscriber panicked", "event", fmt.Sprintf("%T", e), "game_id", e.context().Game.ID, "panic", fmt.Sprint(r))
+		}
+	}()
+	f(e)
+}
+
+// Events waiting for an asynchronous subscriber
+type eventQueue struct {
+	mu      sync.Mutex
+	pending []Event
+	wake    chan struct{} // Signaled when events are added
+}
+
+// Adds an event to the queue without waiting for the subscriber
+func (q *eventQueue) push(e Event) {
+	q.mu.Lock()
+	q.pending = append(q.pending, e)
+	q.mu.Unlock()
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Calls the subscriber with every event as it is queued, forever
+func (q *eventQueue) run(f func(Event)) {
+	for range q.wake {
+		q.mu.Lock()
+		events := q.pending
+		q.pending = nil
+		q.mu.Unlock()
+
+		for _, e := range events {
+			call(f, e)
+		}
+	}
+}
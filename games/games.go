@@ -0,0 +1,243 @@
+package games
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/pdn"
+	"github.com/jmsheff/discord-checkers/storage"
+)
+
+// Where a change to a game came from
+const (
+	SOURCE_DISCORD = "discord"
+	SOURCE_WEB     = "web"
+	SOURCE_CLOCK   = "clock" // A player ran out of time
+)
+
+// The bucket games are saved in
+const BUCKET = "games"
+
+// Longest name a player can have, in characters
+const MAX_NAME = 64
+
+// How long finished games are kept after they end, so they can still be viewed and reviewed for a while
+const FINISHED_KEEP = 7 * 24 * time.Hour
+
+// How often finished games are checked for ones to remove
+const PRUNE_CHECK = time.Hour
+
+// A player in a game
+type Player struct {
+	DiscordID string // ID of the Discord user, empty for players who only play on the web
+	Name      string // Name shown to the other player
+	Token     string // Secret that lets the player move from the web
+}
+
+// A game that can be played from Discord and the web
+type Game struct {
+	ID          string
+	Variant     string
+	Turn        uint8                   // Which players turn it is(1 or 2)
+	Board       string                  // The board as seen by the player whose turn it is
+	Start       string                  // The position the game started from as FEN
+	Players     map[uint8]Player        // The players by the number they play as
+	Moves       []string                // Every move made so far in standard notation
+	Winner      uint8                   // The player who won, 0 while the game is being played
+	Resigned    bool                    // If the game ended because a player resigned
+	TimedOut    bool                    // If the game ended because the loser ran out of time
+	Casual      bool                    // Casual games are just for fun and allow hints
+	TimeControl TimeControl             // The time each player gets, the zero value for no time limit
+	Clocks      map[uint8]time.Duration // Time each player spent on their finished turns, minus the increments they got
+	Started     time.Time               // When the current turn started
+	Created     time.Time
+	Updated     time.Time
+}
+
+// Gets the position of the game
+func (g Game) Position() logic.Game {
+	return logic.Game{Turn: g.Turn, Board: g.Board, Variant: g.Variant}
+}
+
+// Checks if the game is over
+func (g Game) Over() bool {
+	return g.Winner != 0
+}
+
+// Gets the game in PDN
+func (g Game) PDN() pdn.Game {
+	position := g.Position()
+	r := position.Rules()
+	white, black := logic.White(r), 3-logic.White(r)
+
+	result := pdn.UNFINISHED
+	if g.Over() {
+		result = pdn.Result(g.Winner, r)
+	}
+	record := pdn.New(r, g.Players[white].Name, g.Players[black].Name, g.Moves, result)
+	record.SetTag("Date", g.Created.Format("2006.01.02"))
+	if start := logic.NewGame(r); g.Start != logic.FormatFEN(&start) {
+		record.SetTag("FEN", g.Start)
+	}
+	return record
+}
+
+// Gets the time a player has spent thinking, including the current turn
+func (g Game) Clock(player uint8, now time.Time) time.Duration {
+	used := g.Clocks[player]
+	if player == g.Turn && !g.Over() {
+		used += now.Sub(g.Started)
+	}
+	return used
+}
+
+// Adds the time of the current turn to the clock of the player to move, minus the increment, and starts the next turn
+func (g *Game) stopClock(now time.Time) {
+	if g.Clocks == nil {
+		g.Clocks = make(map[uint8]time.Duration)
+	}
+	g.Clocks[g.Turn] += now.Sub(g.Started) - g.TimeControl.Increment
+	g.Started = now
+}
+
+// Gets the player a token belongs to, returns 0 if it belongs to neither
+func (g Game) PlayerWithToken(token string) uint8 {
+	if token == "" {
+		return 0
+	}
+	for number, p := range g.Players {
+		if p.Token == token {
+			return number
+		}
+	}
+	return 0
+}
+
+// Gets the player a Discord user plays as, returns 0 if they aren't playing
+func (g Game) PlayerWithDiscordID(id string) uint8 {
+	for number, p := range g.Players {
+		if p.DiscordID != "" && p.DiscordID == id {
+			return number
+		}
+	}
+	return 0
+}
+
+// Keeps every game in a storage bucket
+type Store struct {
+	store *storage.Store
+	mu    sync.Mutex
+	timed map[string]Game // Timed games that aren't over by their ID, so the clocks can be checked without reading every game
+}
+
+// Creates a game store saving games in a store
+func New(s *storage.Store) *Store {
+	gs := &Store{store: s, timed: make(map[string]Game)}
+	for _, g := range gs.List() {
+		gs.index(g)
+	}
+	return gs
+}
+
+// Keeps the index of timed games up to date with a game that was saved. The store has to be locked
+func (s *Store) index(g Game) {
+	if g.TimeControl.Timed() && !g.Over() {
+		s.timed[g.ID] = g
+	} else {
+		delete(s.timed, g.ID)
+	}
+}
+
+// Gets the timed games that aren't over
+func (s *Store) Timed() []Game {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	games := make([]Game, 0, len(s.timed))
+	for _, g := range s.timed {
+		games = append(games, g)
+	}
+	return games
+}
+
+// Generates a random hexadecimal string
+func randomString(bytes int) string {
+	b := make([]byte, bytes)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Saves a new game
+func (s *Store) add(g Game) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.store.Put(BUCKET, g.ID, g); err != nil {
+		return err
+	}
+	s.index(g)
+	return nil
+}
+
+// Gets a game by its ID
+func (s *Store) Get(id string) (Game, bool) {
+	var g Game
+	ok := s.store.Get(BUCKET, id, &g)
+	return g, ok
+}
+
+// Gets every game, the most recently played first
+func (s *Store) List() []Game {
+	var games []Game
+	for _, id := range s.store.Keys(BUCKET) {
+		if g, ok := s.Get(id); ok {
+			games = append(games, g)
+		}
+	}
+
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].Updated.After(games[j].Updated)
+	})
+	return games
+}
+
+// Removes the games that ended before a time, returns how many were removed
+func (s *Store) Prune(before time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ids []string
+	for _, id := range s.store.Keys(BUCKET) {
+		var g Game
+		if s.store.Get(BUCKET, id, &g) && g.Over() && g.Updated.Before(before) {
+			ids = append(ids, id)
+			delete(s.timed, id)
+		}
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return len(ids), s.store.Delete(BUCKET, ids...)
+}
+
+// Changes a game while holding the lock so two changes can't overwrite each other
+func (s *Store) update(id string, f func(g *Game) error) (Game, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var g Game
+	if !s.store.Get(BUCKET, id, &g) {
+		return Game{}, ErrNotFound
+	}
+	if err := f(&g); err != nil {
+		return Game{}, err
+	}
+	g.Updated = time.Now()
+	if err := s.store.Put(BUCKET, g.ID, g); err != nil {
+		return Game{}, err
+	}
+	s.index(g)
+	return g, nil
+}
@@ -0,0 +1,249 @@
+package games
+
+import (
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Errors returned when a change can't be made
+var (
+	ErrNotFound = errors.New("Game not found")
+	ErrOver     = errors.New("The game is over")
+	ErrStale    = errors.New("The game has changed since this board was sent")
+	ErrTurn     = errors.New("It is not your turn")
+
+	// Returned by the update in Flag when the player still has time, never returned by the service
+	errInTime = errors.New("The player still has time")
+)
+
+// Applies moves to games, saves them and tells subscribers what happened.
+// Games that aren't saved, like Discord games started before games were saved, can still be played through it
+type Service struct {
+	Store *Store
+	Bus   *Bus
+}
+
+// Creates a service for the games in a store
+func NewService(s *Store) *Service {
+	return &Service{Store: s, Bus: &Bus{}}
+}
+
+// Creates and saves a game from a position, moves are the moves already played to reach it
+func (s *Service) Create(start logic.Game, players map[uint8]Player, moves []string, casual bool, control TimeControl, source string, origin interface{}) (Game, error) {
+	if players[1].Name == "" || players[2].Name == "" {
+		return Game{}, errors.New("Both players need a name")
+	}
+	if utf8.RuneCountInString(players[1].Name) > MAX_NAME || utf8.RuneCountInString(players[2].Name) > MAX_NAME {
+		return Game{}, errors.New("Names can be at most " + strconv.Itoa(MAX_NAME) + " characters")
+	}
+
+	now := time.Now()
+	g := Game{
+		ID:          randomString(6),
+		Variant:     start.Rules().Name(),
+		Turn:        start.Turn,
+		Board:       start.Board,
+		Start:       logic.FormatFEN(&start),
+		Players:     make(map[uint8]Player),
+		Moves:       append([]string{}, moves...),
+		Casual:      casual,
+		TimeControl: control,
+		Clocks:      make(map[uint8]time.Duration),
+		Started:     now,
+		Created:     now,
+		Updated:     now,
+	}
+	for number, p := range players {
+		p.Token = randomString(16)
+		g.Players[number] = p
+	}
+	g.Winner = logic.GetWinner(&start)
+
+	if err := s.Store.add(g); err != nil {
+		return Game{}, err
+	}
+	s.Bus.Publish(GameStarted{Context{Game: g, Source: source, Origin: origin}})
+	return g, nil
+}
+
+// Checks that a game is the newest version of the saved game
+func (s *Service) check(g Game) error {
+	if g.ID == "" {
+		return nil
+	}
+	saved, ok := s.Store.Get(g.ID)
+	if !ok {
+		return ErrNotFound
+	} else if saved.Over() {
+		return ErrOver
+	} else if len(saved.Moves) != len(g.Moves) {
+		return ErrStale
+	}
+	return nil
+}
+
+// Adds a step of a move to the moves of a game, steps of a multi jump are added to the same move.
+// Has to be called before the step is made
+func RecordStep(moves []string, game *logic.Game, from logic.Square, move logic.Move) []string {
+	to := strconv.Itoa(logic.SquareNumber(move.S.Index, game))
+	if logic.IsMultiJump(game) && len(moves) > 0 {
+		moves = append([]string{}, moves...)
+		moves[len(moves)-1] += "x" + to
+		return moves
+	}
+
+	separator := "-"
+	if move.IsJump() {
+		separator = "x"
+	}
+	return append(append([]string{}, moves...), strconv.Itoa(logic.SquareNumber(from.Index, game))+separator+to)
+}
+
+// Checks if a piece is a king
+func isKing(piece byte) bool {
+	return piece == '3' || piece == '4'
+}
+
+// Makes a single step of a move for the player to move, like one jump of a multi jump.
+// Returns the game after the step, which still has the jumped pieces on the board if the piece has to keep jumping
+func (s *Service) Step(g Game, from logic.Square, move logic.Move, source string, origin interface{}) (Game, error) {
+	position := g.Position()
+	player := position.Turn
+	if !logic.IsMultiJump(&position) {
+		if err := s.check(g); err != nil {
+			return g, err
+		}
+	}
+
+	g.Moves = RecordStep(g.Moves, &position, from, move)
+	multiJump := logic.MovePiece(from, move, &position)
+	position.Selected = 0
+	g.Board = position.Board
+
+	ctx := Context{Game: g, Source: source, Origin: origin}
+	crowned := !from.IsKing() && isKing(position.Board[move.S.Index])
+	if crowned {
+		s.Bus.Publish(Promoted{Context: ctx, Player: player, Square: logic.SquareNumber(move.S.Index, &position)})
+	}
+	if multiJump {
+		s.Bus.Publish(MultiJumpPending{Context: ctx, Player: player, Index: move.S.Index})
+		return g, nil
+	}
+
+	return s.finish(g, position, player, source, origin)
+}
+
+// Plays a whole move written in standard notation for a player
+func (s *Service) Move(id string, player uint8, notation string, source string, origin interface{}) (Game, error) {
+	g, ok := s.Store.Get(id)
+	if !ok {
+		return Game{}, ErrNotFound
+	} else if g.Over() {
+		return g, ErrOver
+	} else if player != g.Turn {
+		return g, ErrTurn
+	}
+
+	position := g.Position()
+	seq, err := logic.ParseSequence(notation, &position)
+	if err != nil {
+		return g, err
+	}
+	g.Moves = append(g.Moves, logic.FormatSequence(seq, &position))
+	wasKing := isKing(position.Board[seq.From.Index])
+	logic.ApplySequence(seq, &position)
+	g.Board = position.Board
+
+	if !wasKing && isKing(position.Board[seq.To().Index]) {
+		ctx := Context{Game: g, Source: source, Origin: origin}
+		s.Bus.Publish(Promoted{Context: ctx, Player: player, Square: logic.SquareNumber(seq.To().Index, &position)})
+	}
+	return s.finish(g, position, player, source, origin)
+}
+
+// Passes the turn after a move is over, saves the game and tells the subscribers.
+// The position is still as seen by the player who moved
+func (s *Service) finish(g Game, position logic.Game, player uint8, source string, origin interface{}) (Game, error) {
+	next := position
+	logic.SwapTurn(&next)
+	g.Turn, g.Board = next.Turn, next.Board
+	g.Winner = logic.GetWinner(&next)
+
+	if g.ID != "" {
+		// A move made too late loses the game instead
+		if _, flagged := s.Flag(g.ID); flagged {
+			return g, ErrOver
+		}
+		saved, err := s.Store.update(g.ID, func(saved *Game) error {
+			if saved.Over() {
+				return ErrOver
+			} else if len(g.Moves) != len(saved.Moves)+1 {
+				return ErrStale
+			}
+			saved.stopClock(time.Now())
+			saved.Turn, saved.Board, saved.Moves, saved.Winner = g.Turn, g.Board, g.Moves, g.Winner
+			return nil
+		})
+		if err != nil {
+			return g, err
+		}
+		g = saved
+	}
+
+	ctx := Context{Game: g, Source: source, Origin: origin}
+	s.Bus.Publish(MoveApplied{Context: ctx, Player: player, Move: g.Moves[len(g.Moves)-1]})
+	if g.Over() {
+		reason := NO_MOVES
+		if !strings.ContainsAny(next.Board, string(rune('0'+next.Turn))+string(rune('2'+next.Turn))) {
+			reason = NO_PIECES
+		}
+		s.Bus.Publish(GameWon{Context: ctx, Winner: g.Winner, Reason: reason})
+	} else {
+		s.Bus.Publish(TurnChanged{Context: ctx, Turn: g.Turn})
+	}
+	return g, nil
+}
+
+// Ends a game with the other player winning
+func (s *Service) Resign(id string, player uint8, source string, origin interface{}) (Game, error) {
+	if player != 1 && player != 2 {
+		return Game{}, errors.New("Only the players can resign")
+	}
+
+	g, err := s.Store.update(id, func(g *Game) error {
+		if g.Over() {
+			return ErrOver
+		}
+		g.stopClock(time.Now())
+		g.Winner = 3 - player
+		g.Resigned = true
+		return nil
+	})
+	if err != nil {
+		return g, err
+	}
+
+	s.Bus.Publish(GameWon{Context: Context{Game: g, Source: source, Origin: origin}, Winner: g.Winner, Reason: RESIGNED})
+	return g, nil
+}
+
+// Removes finished games once they are older than FINISHED_KEEP so the store doesn't grow forever
+func (s *Service) PruneFinished() {
+	go func() {
+		tick := time.Tick(PRUNE_CHECK)
+		for {
+			if n, err := s.Store.Prune(time.Now().Add(-FINISHED_KEEP)); err != nil {
+				slog.Error("Could not remove finished games", "err", err)
+			} else if n != 0 {
+				slog.Info("Removed finished games", "count", n)
+			}
+			<-tick
+		}
+	}()
+}
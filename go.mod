@@ -1,5 +1,10 @@
 module github.com/jmsheff/discord-checkers
 
-go 1.13
+go 1.21
 
-require github.com/bwmarrin/discordgo v0.20.2
+require (
+	github.com/bwmarrin/discordgo v0.20.2
+	github.com/gorilla/websocket v1.4.0
+)
+
+require golang.org/x/crypto v0.0.0-20181030102418-4d3f4d9ffa16 // indirect
@@ -0,0 +1,419 @@
+package i18n
+
+// Messages in english, every other language translates these
+var english = Language{
+	Code:   "en",
+	Name:   "English",
+	Plural: oneOther,
+	Messages: map[string]Message{
+		// Colors, directions and variants
+		"color.blue":            {Other: "🔵 Blue"},
+		"color.red":             {Other: "🔴 Red"},
+		"direction.nw":          {Other: "Northwest"},
+		"direction.ne":          {Other: "Northeast"},
+		"direction.sw":          {Other: "Southwest"},
+		"direction.se":          {Other: "Southeast"},
+		"variant.american":      {Other: "Checkers"},
+		"variant.international": {Other: "International draughts"},
+		"variant.russian":       {Other: "Russian draughts"},
+		"variant.brazilian":     {Other: "Brazilian draughts"},
+		"variant.italian":       {Other: "Italian draughts"},
+		"variant.spanish":       {Other: "Spanish draughts"},
+		"variant.giveaway":      {Other: "Giveaway checkers"},
+
+		// Errors shared by every command
+		"error.bot":          {Other: "Bot error"},
+		"error.dm":           {Other: "Error creating direct message."},
+		"error.opponent":     {Other: "Error getting opponent"},
+		"error.channel":      {Other: "Error getting channel."},
+		"error.invalid":      {Other: "Invalid channel"},
+		"error.dm.open":      {Other: "Could not open DM with opponent"},
+		"error.dm.send":      {Other: "Could not send opponent message"},
+		"error.game.title":   {Other: "Error getting game"},
+		"error.game":         {Other: "This game has most likely expired or doesn't exist."},
+		"error.deselect":     {Other: "Could not deselect piece"},
+		"error.unknown":      {Other: "Something went wrong, try again."},
+		"error.messages":     {Other: "Could not get the messages in this channel."},
+		"error.channel.game": {Other: "There is no game in this channel."},
+		"error.stale":        {Other: "The game has changed since this board was sent."},
+		"error.turn":         {Other: "It is not your turn."},
+		"error.coordinates":  {Other: "Invalid coordinates"},
+		"error.empty":        {Other: "Cannot select blank space"},
+		"error.piece":        {Other: "Cannot select other players piece"},
+		"error.jump.same":    {Other: "Must keep jumping with the same piece"},
+		"error.jump.other":   {Other: "Another piece has to jump"},
+		"error.nowhere":      {Other: "Piece has nowhere to move"},
+		"error.fen":          {Other: "This is not a position in FEN, like W:W21,22,K23:B1,2,3"},
+		"error.notation":     {Other: "Invalid move notation"},
+		"error.impossible":   {Other: "Move not possible"},
+		"error.ambiguous":    {Other: "Ambiguous move, write out every square"},
+		"error.variant":      {Other: "Unknown variant"},
+		"error.moves":        {Other: "The player to move has no moves."},
+
+		// Boards
+		"game.title":           {Other: "%[1]s game against %[2]s"},
+		"game.status":          {Other: "Status"},
+		"game.status.turn":     {Other: "Your move"},
+		"game.status.waiting":  {Other: "Waiting for opponent..."},
+		"game.playing":         {Other: "You are playing as %[1]s"},
+		"game.pieces":          {Other: "Your pieces: %[1]s men, %[2]s kings"},
+		"game.opening":         {Other: "Opening: %[1]s"},
+		"game.casual":          {Other: "Casual game"},
+		"game.casual.hints":    {Other: "Casual game, type `%[1]s hint` for a hint"},
+		"game.captured.red":    {One: "%[1]d Red piece captured", Other: "%[1]d Red pieces captured"},
+		"game.captured.blue":   {One: "%[1]d Blue piece captured", Other: "%[1]d Blue pieces captured"},
+		"game.none":            {Other: "None"},
+		"game.board":           {Other: "Board"},
+		"game.image":           {Other: "See the image below"},
+		"game.moves":           {Other: "Moves"},
+		"game.move":            {Other: "%[1]s to %[2]s"},
+		"game.move.direction":  {Other: "%[1]s %[2]s to %[3]s"},
+		"game.help":            {Other: "Help"},
+		"game.help.topic":      {Other: "For help type `%[1]s help %[2]s`"},
+		"game.help.topics":     {Other: "For help type `%[1]s help`"},
+		"game.clock":           {Other: "Time left (%[1]s)"},
+		"game.puzzle.title":    {Other: "🧩 Puzzle #%[1]d - %[2]s"},
+		"game.puzzle":          {Other: "Find the winning moves as %[1]s, the bot plays the other side."},
+		"game.start.title":     {Other: "Game on!"},
+		"game.start.wait":      {Other: "You are playing as %[1]s. Wait here for %[2]s to make their move."},
+		"game.start.accepted":  {Other: "%[1]s accepted your checkers invite! You are playing as %[2]s. Wait here for them to make their move."},
+		"game.over.title":      {Other: "Game over"},
+		"game.over":            {Other: "This game has already ended."},
+		"game.won.title":       {Other: "🎉 YOU WIN!!! 🏆"},
+		"game.won":             {Other: "Congratulations! You won the game against %[1]s. %[2]s"},
+		"game.lost.title":      {Other: "❌ You lost. ❌"},
+		"game.lost":            {Other: "You lost the game against %[1]s. %[2]s Better luck next time!"},
+		"game.reason.resigned": {Other: "%[1]s resigned."},
+		"game.reason.pieces":   {Other: "%[1]s has no pieces left."},
+		"game.reason.moves":    {Other: "%[1]s has no moves left."},
+		"game.reason.time":     {Other: "%[1]s ran out of time."},
+		"move.sent.title":      {Other: "Move sent!"},
+		"move.sent":            {Other: "Wait here for them to make their move."},
+		"move.sent.web":        {Other: "You played %[1]s on the web. Wait here for them to make their move."},
+
+		// Invites
+		"invite.title":            {Other: "Checkers game invite from %[1]s"},
+		"invite.direct":           {Other: "Click the  ✅  to accept this invitation, or the  ❌  to deny."},
+		"invite.general":          {Other: "Click the  ✅  to accept this invitation."},
+		"invite.variant":          {Other: "Variant: %[1]s"},
+		"invite.ballot":           {Other: "Opening ballot: %[1]s"},
+		"invite.casual":           {Other: "Casual game with hints"},
+		"invite.time":             {Other: "Time control: %[1]s"},
+		"invite.color":            {Other: "You will play as %[1]s."},
+		"invite.sent.title":       {Other: "Success"},
+		"invite.sent":             {Other: "Invite sent to %[1]s! You will play as %[2]s."},
+		"invite.accepted.title":   {Other: "Invite Accepted!"},
+		"invite.accepted":         {Other: "Invite from %[1]s accepted!"},
+		"invite.declined.title":   {Other: "Invite Declined"},
+		"invite.declined":         {Other: "Invite from %[1]s declined."},
+		"invite.refused.title":    {Other: "Invite declined"},
+		"invite.refused":          {Other: "%[1]s declined your checkers game invite."},
+		"invite.error.option":     {Other: "Invalid option"},
+		"invite.error.variant":    {Other: "Unknown variant"},
+		"invite.error.ballot":     {Other: "Ballots can only be played in american checkers"},
+		"invite.error.options":    {Other: "Invalid options"},
+		"invite.error.help":       {Other: "%[1]s. For a list of options type `%[2]s help invites`"},
+		"invite.error.recipient":  {Other: "Invalid recipient"},
+		"invite.error.yourself":   {Other: "Cannot play against yourself!"},
+		"invite.error.bot":        {Other: "Cannot play against bot!"},
+		"invite.error.mention":    {Other: "Ensure you are mentioning the player in the format of @<user>. Or, if you are trying to send a general invite leave the user blank."},
+		"invite.error.invalid":    {Other: "Invalid invite"},
+		"invite.error.multiple":   {Other: "Cannot invite multiple players!"},
+		"invite.error.ai":         {Other: "Games against the bot are turned off in this server."},
+		"invite.error.dm":         {Other: "Cannot send invites from a DM"},
+		"invite.error.channels":   {Other: "Invites can only be sent in %[1]s"},
+		"invite.error.send":       {Other: "Error sending invite."},
+		"invite.error.ballot.bot": {Other: "Could not play the opening ballot."},
+
+		// Language command
+		"language.title":       {Other: "🗣️  Language: %[1]s"},
+		"language.description": {Other: "Change it with `%[1]s language <code>`, or `%[1]s language auto` to use the language of the server. Available languages: %[2]s"},
+		"language.error.title": {Other: "Invalid language"},
+		"language.error":       {Other: "Available languages: %[1]s"},
+		"language.error.save":  {Other: "Could not save your language."},
+
+		// Accessible mode
+		"accessible.red":         {Other: "Red men: %[1]s. Red kings: %[2]s."},
+		"accessible.blue":        {Other: "Blue men: %[1]s. Blue kings: %[2]s."},
+		"accessible.none":        {Other: "none"},
+		"accessible.move":        {Other: "%[1]s to %[2]s"},
+		"accessible.capture":     {One: "%[2]s jumps to %[3]s, capturing %[1]d piece", Other: "%[2]s jumps to %[3]s, capturing %[1]d pieces"},
+		"accessible.last":        {Other: "Last move: %[1]s."},
+		"accessible.play":        {Other: "Type `%[1]s play <number>` to make one of the moves below."},
+		"accessible.on.title":    {Other: "Accessible mode on"},
+		"accessible.on":          {Other: "Boards are described in words and the moves you can make are numbered, type `%[1]s play <number>` in the DM with the game to make one. `%[1]s accessible off` shows emoji boards again."},
+		"accessible.off.title":   {Other: "Accessible mode off"},
+		"accessible.off":         {Other: "Boards are shown with emojis again."},
+		"accessible.error.title": {Other: "Invalid option"},
+		"accessible.error":       {Other: "Use `%[1]s accessible on` or `%[1]s accessible off`."},
+		"accessible.error.save":  {Other: "Could not save your setting."},
+		"play.error.title":       {Other: "Cannot play"},
+		"play.error.turn":        {Other: "There is no board waiting for your move in this channel."},
+		"play.error.number":      {Other: "Choose one of the numbered moves, from 1 to %[1]d."},
+		"play.error.game":        {Other: "This game can only be played with reactions."},
+
+		// Commands
+		"command.missing.title": {Other: "Command missing"},
+		"command.missing":       {Other: "For a list of commands type %[1]s help"},
+		"command.invalid.title": {Other: "Invalid command"},
+		"command.invalid":       {Other: "For a list of help topics, type %[1]s help"},
+		"command.ping":          {Other: "Pong! %[1]d queued actions, %[2]s average latency"},
+
+		// Games against the bot
+		"ai.error.start": {Other: "Could not start the game."},
+		"ai.start":       {Other: "Check your DMs, you are playing as %[1]s against the bot."},
+		"ai.start.wait":  {Other: "You are playing as %[1]s. Wait here for the bot to make its move."},
+
+		// Web board
+		"web.stale.title":       {Other: "Game moved on"},
+		"web.stale":             {Other: "This board is out of date because the game was continued on the web. Use the newest board instead."},
+		"web.error.title":       {Other: "Web board unavailable"},
+		"web.error":             {Other: "This bot doesn't run a web board."},
+		"web.error.games.title": {Other: "No games"},
+		"web.error.games":       {Other: "You aren't playing any games right now."},
+		"web.link":              {Other: "Against %[1]s as %[2]s: %[3]s"},
+		"web.title":             {Other: "Your games on the web"},
+		"web.links":             {Other: "Don't share these links, anyone with one can move for you.\n%[1]s"},
+		"web.sent.title":        {Other: "Links sent!"},
+		"web.sent":              {Other: "Links to your games were sent to your DMs."},
+
+		// Puzzles
+		"puzzle.description":       {Other: "%[1]s: %[2]s to move and win. Rating: %[3]d"},
+		"puzzle.rating.title":      {Other: "Puzzle rating"},
+		"puzzle.rating":            {Other: "%[1]s has a puzzle rating of %[2]d. Solved: %[3]d, failed: %[4]d."},
+		"puzzle.rating.change":     {Other: "Your puzzle rating is now %[1]d (%[2]s)."},
+		"puzzle.error.title":       {Other: "Invalid puzzle"},
+		"puzzle.error":             {Other: "There is no puzzle #%[1]d."},
+		"puzzle.error.setup":       {Other: "Could not set up the puzzle."},
+		"puzzle.error.send":        {Other: "Error sending puzzle."},
+		"puzzle.error.defence":     {Other: "Could not play the defence."},
+		"puzzle.done.title":        {Other: "All done!"},
+		"puzzle.done":              {Other: "You have tried every puzzle. Type `%[1]s puzzle <number>` to try one again."},
+		"puzzle.sent.title":        {Other: "Puzzle sent!"},
+		"puzzle.sent":              {Other: "Puzzle #%[1]d was sent to your DMs."},
+		"puzzle.correct.title":     {Other: "Correct!"},
+		"puzzle.correct":           {Other: "The bot answered with %[1]s. Keep going!"},
+		"puzzle.solved.title":      {Other: "Puzzle solved!"},
+		"puzzle.solved":            {Other: "Well done, you found every move of puzzle #%[1]d."},
+		"puzzle.failed.title":      {Other: "Not quite"},
+		"puzzle.failed":            {Other: "The solution was %[1]s."},
+		"puzzle.next":              {Other: "Type `%[1]s puzzle` for another one."},
+		"puzzle.daily.title":       {Other: "🧩 Daily puzzle - %[1]s"},
+		"puzzle.daily.solve.title": {Other: "Solve it"},
+		"puzzle.daily.solve":       {Other: "Type `%[1]s puzzle daily` and the bot will send it to your DMs."},
+
+		// Board themes
+		"theme.title":              {Other: "🎨 Board theme: %[1]s"},
+		"theme.pieces":             {Other: "%[1]s: %[2]s men, %[3]s kings"},
+		"theme.images.title":       {Other: "Images"},
+		"theme.images.on":          {Other: "On"},
+		"theme.images.off":         {Other: "Off"},
+		"theme.images.web":         {Other: "On, but this bot doesn't run the web board so boards are still sent as emojis"},
+		"theme.change.title":       {Other: "Change it"},
+		"theme.change":             {Other: "`%[1]s theme <name>` with one of %[2]s\n`%[1]s theme images on` shows boards as images"},
+		"theme.error.title":        {Other: "Invalid theme"},
+		"theme.error":              {Other: "Available themes: %[1]s"},
+		"theme.error.images.title": {Other: "Invalid option"},
+		"theme.error.images":       {Other: "Use `%[1]s theme images on` or `%[1]s theme images off`."},
+		"theme.error.custom.title": {Other: "No custom emojis"},
+		"theme.error.custom":       {Other: "Use this in a server that has set its emojis with `%[1]s config emojis`."},
+		"theme.error.save":         {Other: "Could not save your theme."},
+
+		// Server settings
+		"config.title":                  {Other: "⚙️  Server settings"},
+		"config.description":            {Other: "Change a setting with `%[1]s config <setting> <value>`, only members who can manage the server can change them."},
+		"config.none":                   {Other: "None"},
+		"config.on":                     {Other: "On"},
+		"config.off":                    {Other: "Off"},
+		"config.prefix.title":           {Other: "Prefix"},
+		"config.prefix":                 {Other: "`%[1]s`\n`%[1]s config prefix <prefix>`"},
+		"config.invites.title":          {Other: "Invite channels"},
+		"config.invites":                {Other: "%[1]s\n`%[2]s config invites <#channel>...` or `all`"},
+		"config.invites.all":            {Other: "Every channel"},
+		"config.variant.title":          {Other: "Default variant"},
+		"config.variant":                {Other: "%[1]s\n`%[2]s config variant <name>`"},
+		"config.time.title":             {Other: "Time control"},
+		"config.time":                   {Other: "%[1]s\n`%[2]s config time <minutes+increment>` or `off`"},
+		"config.time.off":               {Other: "No time limit"},
+		"config.spectators.title":       {Other: "Spectator channel"},
+		"config.spectators":             {Other: "%[1]s\n`%[2]s config spectators <#channel>` or `off`"},
+		"config.reports.title":          {Other: "Report channel"},
+		"config.reports":                {Other: "%[1]s\n`%[2]s config reports <#channel>` or `off`"},
+		"config.ai.title":               {Other: "Games against the bot"},
+		"config.ai":                     {Other: "%[1]s\n`%[2]s config ai on` or `off`"},
+		"config.theme.title":            {Other: "Board theme"},
+		"config.theme":                  {Other: "%[1]s\n`%[2]s config theme <name>`"},
+		"config.emojis.title":           {Other: "Custom emojis"},
+		"config.emojis":                 {Other: "%[1]s\n`%[2]s config emojis <blue man> <red man> <blue king> <red king>`, members choose them with `%[2]s theme custom`"},
+		"config.language.title":         {Other: "Language"},
+		"config.language":               {Other: "%[1]s\n`%[2]s config language <code>` with one of %[3]s, members choose their own with `%[2]s language`"},
+		"config.error.title":            {Other: "Invalid setting"},
+		"config.error":                  {Other: "%[1]s. Type `%[2]s config` to see every setting."},
+		"config.error.value":            {Other: "Missing value"},
+		"config.error.setting":          {Other: "Unknown setting"},
+		"config.error.prefix":           {Other: "The prefix can't contain spaces"},
+		"config.error.channels":         {Other: "Mention the channels like #channel"},
+		"config.error.channel":          {Other: "Mention the channel like #channel"},
+		"config.error.variant":          {Other: "Unknown variant. Available variants: %[1]s"},
+		"config.error.time":             {Other: "Invalid time control, use minutes+increment like 10+5"},
+		"config.error.ai":               {Other: "Use on or off"},
+		"config.error.theme":            {Other: "Available themes: %[1]s"},
+		"config.error.emojis":           {Other: "Give four custom emojis"},
+		"config.error.emoji":            {Other: "Only custom emojis of a server can be used"},
+		"config.error.language":         {Other: "Available languages: %[1]s"},
+		"config.error.guild":            {Other: "Settings can only be changed in a server"},
+		"config.error.permissions":      {Other: "Could not check your permissions."},
+		"config.error.permission.title": {Other: "Missing permission"},
+		"config.error.permission":       {Other: "Only members who can manage the server can change its settings."},
+		"config.error.save":             {Other: "Could not save the settings."},
+
+		// Selection
+		"select.error.title": {Other: "Invalid reactions"},
+		"select.error":       {Other: "Ensure you have reacted with 1 letter and 1 number. Adjust your reactions and try again."},
+		"select.error.retry": {Other: "Adjust your reactions and try again."},
+
+		// Hints and analysis
+		"hint.title":                    {Other: "💡 Hint"},
+		"hint.select":                   {Other: "Select %[1]s %[2]s and move the piece to the 🎯"},
+		"hint.jump":                     {Other: "Keep jumping to the 🎯"},
+		"hint.move":                     {Other: "Move: %[1]s"},
+		"hint.on.title":                 {Other: "Hints turned on"},
+		"hint.off.title":                {Other: "Hints turned off"},
+		"hint.changed":                  {Other: "This applies to the rest of the game."},
+		"hint.off":                      {Other: "Type `%[1]s hint on` to turn hints back on for this game."},
+		"hint.error.title":              {Other: "No hint"},
+		"hint.error.game":               {Other: "No game"},
+		"hint.error.casual.title":       {Other: "Hints not allowed"},
+		"hint.error.casual":             {Other: "Hints can only be used in casual games. Add `casual` to an invite to start one."},
+		"hint.error.turn.title":         {Other: "Not your turn"},
+		"hint.error.turn":               {Other: "Wait for your opponent to make their move."},
+		"hint.error.option.title":       {Other: "Invalid option"},
+		"hint.error.option":             {Other: "Use `%[1]s hint on` or `%[1]s hint off` to turn hints on or off."},
+		"hint.error.update":             {Other: "Could not update the game."},
+		"analysis.title":                {Other: "🔍 Analysis - %[1]s"},
+		"analysis.description":          {One: "%[2]s to move, searched %[1]d ply deep.\nFEN: `%[3]s`", Other: "%[2]s to move, searched %[1]d plies deep.\nFEN: `%[3]s`"},
+		"analysis.score.win":            {One: "Wins in %[1]d ply", Other: "Wins in %[1]d plies"},
+		"analysis.score.loss":           {One: "Loses in %[1]d ply", Other: "Loses in %[1]d plies"},
+		"analysis.error.title":          {Other: "Cannot analyze"},
+		"analysis.error.variant.title":  {Other: "Invalid variant"},
+		"analysis.error.variant":        {Other: "Available variants: %[1]s"},
+		"analysis.error.position.title": {Other: "Position missing"},
+		"analysis.error.position":       {Other: "Type `%[1]s analyze <FEN>` to analyze a position. For help type `%[1]s help analysis`"},
+		"analysis.error.fen.title":      {Other: "Invalid position"},
+
+		// Game reports
+		"report.analyzing":        {Other: "📊 Analyzing the game, the report will be posted here shortly..."},
+		"report.error":            {Other: "Could not analyze the game."},
+		"report.title":            {Other: "📊 Game report - %[1]s"},
+		"report.summary":          {Other: "%[1]s %[2]s: %[3]s, %[4]s, %[5]s"},
+		"report.blunders":         {One: "%[1]d blunder", Other: "%[1]d blunders"},
+		"report.mistakes":         {One: "%[1]d mistake", Other: "%[1]d mistakes"},
+		"report.inaccuracies":     {One: "%[1]d inaccuracy", Other: "%[1]d inaccuracies"},
+		"report.good":             {Other: "Good move"},
+		"report.inaccuracy":       {Other: "Inaccuracy"},
+		"report.mistake":          {Other: "Mistake"},
+		"report.blunder":          {Other: "Blunder"},
+		"report.judgement":        {Other: "%[1]s, %[2]s was better (%[3]s instead of %[4]s)"},
+		"report.evaluation.title": {Other: "Evaluation"},
+		"report.evaluation":       {Other: "Above the line is good for %[1]s."},
+		"report.moments.title":    {Other: "Key moments"},
+		"report.moments.none":     {Other: "No inaccuracies, mistakes or blunders found."},
+		"report.moments.more":     {One: "...and %[1]d more in the PDN", Other: "...and %[1]d more in the PDN"},
+
+		// Adjudication
+		"adjudicate.title":         {Other: "Adjudication"},
+		"adjudicate.error.title":   {Other: "Cannot adjudicate"},
+		"adjudicate.error.capture": {Other: "Finish the capture before adjudicating the game."},
+		"adjudicate.error.variant": {Other: "There is no endgame database for %[1]s."},
+		"adjudicate.error.pieces":  {One: "The game has %[1]d piece left, the endgame database only knows positions with up to %[2]d pieces.", Other: "The game has %[1]d pieces left, the endgame database only knows positions with up to %[2]d pieces."},
+		"adjudicate.win":           {One: "%[2]s wins with perfect play in %[1]d ply.", Other: "%[2]s wins with perfect play in %[1]d plies."},
+		"adjudicate.draw":          {Other: "Neither player can force a win, the game is a draw with perfect play."},
+
+		// Spectators and stats
+		"spectate.title": {Other: "👀 %[1]s game"},
+		"spectate.turn":  {Other: "%[1]s to move"},
+		"spectate.last":  {Other: "Last move"},
+		"spectate.won":   {Other: "%[1]s won. %[2]s"},
+		"stats.title":    {Other: "Stats of %[1]s"},
+		"stats":          {Other: "Games played: %[1]d\nWon: %[2]d\nLost: %[3]d"},
+
+		// Help
+		"help.invites.title":          {Other: "✉️  Invites - Checkers Help"},
+		"help.invites":                {Other: "Invites allow you to start a game with a player. Invites CANNOT be sent:\n  • Through DM\n  • By or to bots\n  • To yourself\nSee below for available commands."},
+		"help.invites.general.title":  {Other: "General invites"},
+		"help.invites.general":        {Other: "`%[1]s invite`: Sends an invite to the channel the command was sent in for any user to accept"},
+		"help.invites.direct.title":   {Other: "Direct invites"},
+		"help.invites.direct":         {Other: "`%[1]s invite @<user>`: Sends an invite directly to the mentioned user."},
+		"help.invites.colors.title":   {Other: "Colors"},
+		"help.invites.colors":         {Other: "Add `red`, `blue` or `random` to either invite to choose which color you play as. Red always moves first. If no color is given you play as blue."},
+		"help.invites.ballots.title":  {Other: "Ballots"},
+		"help.invites.ballots":        {Other: "Add `ballot` to either invite to start an american checkers game from a randomly drawn three move opening, like in competitive play."},
+		"help.invites.casual.title":   {Other: "Casual games"},
+		"help.invites.casual":         {Other: "Add `casual` to either invite to play a casual game. Players can ask the bot for hints in casual games."},
+		"help.invites.variants.title": {Other: "Variants"},
+		"help.invites.variants":       {Other: "Add `variant:<name>` to either invite to play a different variant. Available variants: %[1]s. If no variant is given you play american checkers. In `giveaway` the first player to lose all their pieces or get blocked wins."},
+
+		"help.select.title":         {Other: "⏺  Selection - Checkers Help"},
+		"help.select":               {Other: "Selection is done through reactions. Valid selection reactions are set by the bot. Select a piece by reacting with the corresponding emojis."},
+		"help.select.example.title": {Other: "Example"},
+		"help.select.example":       {Other: "To select the piece at the square F1 react with  🇫  followed by  1️⃣ ."},
+		"help.select.confirm.title": {Other: "Confirmation"},
+		"help.select.confirm":       {Other: "Confirm a selection by reacting with a  ✅ . This will validate your selection and show the available moves for the selected piece on the board."},
+
+		"help.move.title":          {Other: "↗️  Movement - Checkers Help"},
+		"help.move":                {Other: "Movement is done through reactions. Valid movement reactions are set by the bot. The moves for the piece you selected are shown on the board. Move the piece by reacting with the corresponding emoji."},
+		"help.move.example.title":  {Other: "Example"},
+		"help.move.example":        {Other: "To move the selected piece to the square Northeast of itself, react with a  ↗️"},
+		"help.move.numbered.title": {Other: "Numbered moves"},
+		"help.move.numbered":       {Other: "When a piece can move more than one square in the same direction, like kings in international draughts, the moves are numbered instead. React with the number shown on the square you want to move to."},
+		"help.move.cancel.title":   {Other: "Cancel"},
+		"help.move.cancel":         {Other: "To select a different piece, react with a  ❌ . This will bring you back to the selection step."},
+
+		"help.analysis.title":         {Other: "🔍  Analysis - Checkers Help"},
+		"help.analysis":               {Other: "The bot can look at positions and suggest the best moves."},
+		"help.analysis.hints.title":   {Other: "Hints"},
+		"help.analysis.hints":         {Other: "`%[1]s hint`: Shows the best move on the board. Only works on your turn in casual games, type it in the DM with the game."},
+		"help.analysis.off.title":     {Other: "Turning hints off"},
+		"help.analysis.off":           {Other: "`%[1]s hint off`: Turns hints off for the rest of the game, `%[1]s hint on` turns them back on."},
+		"help.analysis.analyze.title": {Other: "Analyze a position"},
+		"help.analysis.analyze":       {Other: "`%[1]s analyze <FEN>`: Shows the three best moves in a position written in FEN, for example `%[1]s analyze W:W21,22,K23:B1,2,3`. Add `variant:<name>` to analyze a position of another variant."},
+
+		"help.puzzles.title":        {Other: "🧩  Puzzles - Checkers Help"},
+		"help.puzzles":              {Other: "Puzzles are positions with a single way to win. The bot sends them to your DMs, make your moves like in a normal game and the bot plays the other side."},
+		"help.puzzles.start.title":  {Other: "Start a puzzle"},
+		"help.puzzles.start":        {Other: "`%[1]s puzzle`: Sends a puzzle close to your puzzle rating that you haven't tried yet."},
+		"help.puzzles.daily.title":  {Other: "Daily puzzle"},
+		"help.puzzles.daily":        {Other: "`%[1]s puzzle daily`: Sends the puzzle of the day."},
+		"help.puzzles.choose.title": {Other: "Choose a puzzle"},
+		"help.puzzles.choose":       {Other: "`%[1]s puzzle <number>`: Sends the puzzle with the given number."},
+		"help.puzzles.rating.title": {Other: "Rating"},
+		"help.puzzles.rating":       {Other: "`%[1]s puzzle rating`: Shows your puzzle rating. Only the first try at each puzzle changes your rating."},
+
+		"help.topics.title":            {Other: "ℹ️  Topics - Checkers Help"},
+		"help.topics":                  {Other: "Pick a topic below to get help"},
+		"help.topics.invites.title":    {Other: "✉️  Invites"},
+		"help.topics.invites":          {Other: "`%[1]s help invites`:  Gives instruction on how to send invites "},
+		"help.topics.select.title":     {Other: "⏺  Selection"},
+		"help.topics.select":           {Other: "`%[1]s help select`: Gives help on how to select a piece"},
+		"help.topics.move.title":       {Other: "↗️  Movement"},
+		"help.topics.move":             {Other: "`%[1]s help move`: Provides help on how to move a piece"},
+		"help.topics.analysis.title":   {Other: "🔍  Analysis"},
+		"help.topics.analysis":         {Other: "`%[1]s help analysis`: Gives help on hints and analyzing positions"},
+		"help.topics.puzzles.title":    {Other: "🧩  Puzzles"},
+		"help.topics.puzzles":          {Other: "`%[1]s help puzzles`: Gives help on solving puzzles"},
+		"help.topics.web.title":        {Other: "🌐  Web board"},
+		"help.topics.web":              {Other: "`%[1]s web`: Sends you links to play your games in a browser, moves made there show up here too"},
+		"help.topics.theme.title":      {Other: "🎨  Board theme"},
+		"help.topics.theme":            {Other: "`%[1]s theme <name>`: Changes how your boards look, `shapes` and `contrast` don't rely on colors. `%[1]s theme images on` shows boards as images"},
+		"help.topics.language.title":   {Other: "🗣️  Language"},
+		"help.topics.language":         {Other: "`%[1]s language <code>`: Changes the language the bot talks to you in. Available languages: %[2]s"},
+		"help.topics.accessible.title": {Other: "♿  Accessible mode"},
+		"help.topics.accessible":       {Other: "`%[1]s accessible on`: Describes boards in words for screen readers, the moves are numbered and played with `%[1]s play <number>`"},
+		"help.topics.config.title":     {Other: "⚙️  Settings"},
+		"help.topics.config":           {Other: "`%[1]s config`: Shows the settings of the server, members who can manage the server can change the prefix, invite channels, default variant, time control, spectator channel, games against the bot, board theme, custom emojis and language"},
+		"help.topics.stats.title":      {Other: "📊  Stats"},
+		"help.topics.stats":            {Other: "`%[1]s stats [@<user>]`: Shows how many games you or the mentioned user won and lost"},
+		"help.topics.adjudicate.title": {Other: "⚖️  Adjudication"},
+		"help.topics.adjudicate":       {Other: "`%[1]s adjudicate`: Looks up the game in your DM in the endgame database and tells who wins with perfect play"},
+	},
+}
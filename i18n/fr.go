@@ -0,0 +1,419 @@
+package i18n
+
+// Messages in french
+var french = Language{
+	Code:   "fr",
+	Name:   "Français",
+	Plural: zeroOneOther,
+	Messages: map[string]Message{
+		// Colors, directions and variants
+		"color.blue":            {Other: "🔵 Bleu"},
+		"color.red":             {Other: "🔴 Rouge"},
+		"direction.nw":          {Other: "Nord-ouest"},
+		"direction.ne":          {Other: "Nord-est"},
+		"direction.sw":          {Other: "Sud-ouest"},
+		"direction.se":          {Other: "Sud-est"},
+		"variant.american":      {Other: "Dames anglaises"},
+		"variant.international": {Other: "Dames internationales"},
+		"variant.russian":       {Other: "Dames russes"},
+		"variant.brazilian":     {Other: "Dames brésiliennes"},
+		"variant.italian":       {Other: "Dames italiennes"},
+		"variant.spanish":       {Other: "Dames espagnoles"},
+		"variant.giveaway":      {Other: "Qui perd gagne"},
+
+		// Errors shared by every command
+		"error.bot":          {Other: "Erreur du bot"},
+		"error.dm":           {Other: "Impossible de créer le message privé."},
+		"error.opponent":     {Other: "Impossible de trouver l'adversaire"},
+		"error.channel":      {Other: "Impossible de trouver le salon."},
+		"error.invalid":      {Other: "Salon invalide"},
+		"error.dm.open":      {Other: "Impossible d'ouvrir un message privé avec l'adversaire"},
+		"error.dm.send":      {Other: "Impossible d'envoyer le message à l'adversaire"},
+		"error.game.title":   {Other: "Impossible de trouver la partie"},
+		"error.game":         {Other: "Cette partie a sans doute expiré ou n'existe pas."},
+		"error.deselect":     {Other: "Impossible de désélectionner le pion"},
+		"error.unknown":      {Other: "Une erreur est survenue, réessayez."},
+		"error.messages":     {Other: "Impossible de lire les messages de ce salon."},
+		"error.channel.game": {Other: "Il n'y a pas de partie dans ce salon."},
+		"error.stale":        {Other: "La partie a changé depuis l'envoi de ce plateau."},
+		"error.turn":         {Other: "Ce n'est pas votre tour."},
+		"error.coordinates":  {Other: "Coordonnées invalides"},
+		"error.empty":        {Other: "Impossible de sélectionner une case vide"},
+		"error.piece":        {Other: "Impossible de sélectionner une pièce de l'adversaire"},
+		"error.jump.same":    {Other: "Il faut continuer à sauter avec la même pièce"},
+		"error.jump.other":   {Other: "Une autre pièce doit prendre"},
+		"error.nowhere":      {Other: "Cette pièce ne peut pas bouger"},
+		"error.fen":          {Other: "Ce n'est pas une position en FEN, comme W:W21,22,K23:B1,2,3"},
+		"error.notation":     {Other: "Notation de coup invalide"},
+		"error.impossible":   {Other: "Coup impossible"},
+		"error.ambiguous":    {Other: "Coup ambigu, écrivez chaque case"},
+		"error.variant":      {Other: "Variante inconnue"},
+		"error.moves":        {Other: "Le joueur au trait n'a aucun coup."},
+
+		// Boards
+		"game.title":           {Other: "%[1]s contre %[2]s"},
+		"game.status":          {Other: "Statut"},
+		"game.status.turn":     {Other: "À vous de jouer"},
+		"game.status.waiting":  {Other: "En attente de l'adversaire..."},
+		"game.playing":         {Other: "Vous jouez les %[1]s"},
+		"game.pieces":          {Other: "Vos pièces : %[1]s pions, %[2]s dames"},
+		"game.opening":         {Other: "Ouverture : %[1]s"},
+		"game.casual":          {Other: "Partie amicale"},
+		"game.casual.hints":    {Other: "Partie amicale, tapez `%[1]s hint` pour un indice"},
+		"game.captured.red":    {One: "%[1]d pièce rouge prise", Other: "%[1]d pièces rouges prises"},
+		"game.captured.blue":   {One: "%[1]d pièce bleue prise", Other: "%[1]d pièces bleues prises"},
+		"game.none":            {Other: "Aucune"},
+		"game.board":           {Other: "Plateau"},
+		"game.image":           {Other: "Voir l'image ci-dessous"},
+		"game.moves":           {Other: "Coups"},
+		"game.move":            {Other: "%[1]s vers %[2]s"},
+		"game.move.direction":  {Other: "%[1]s %[2]s vers %[3]s"},
+		"game.help":            {Other: "Aide"},
+		"game.help.topic":      {Other: "Pour de l'aide tapez `%[1]s help %[2]s`"},
+		"game.help.topics":     {Other: "Pour de l'aide tapez `%[1]s help`"},
+		"game.clock":           {Other: "Temps restant (%[1]s)"},
+		"game.puzzle.title":    {Other: "🧩 Problème n°%[1]d - %[2]s"},
+		"game.puzzle":          {Other: "Trouvez les coups gagnants avec les %[1]s, le bot joue l'autre camp."},
+		"game.start.title":     {Other: "C'est parti !"},
+		"game.start.wait":      {Other: "Vous jouez les %[1]s. Attendez ici que %[2]s joue son coup."},
+		"game.start.accepted":  {Other: "%[1]s a accepté votre invitation ! Vous jouez les %[2]s. Attendez ici son premier coup."},
+		"game.over.title":      {Other: "Partie terminée"},
+		"game.over":            {Other: "Cette partie est déjà terminée."},
+		"game.won.title":       {Other: "🎉 VOUS AVEZ GAGNÉ !!! 🏆"},
+		"game.won":             {Other: "Félicitations ! Vous avez gagné la partie contre %[1]s. %[2]s"},
+		"game.lost.title":      {Other: "❌ Vous avez perdu. ❌"},
+		"game.lost":            {Other: "Vous avez perdu la partie contre %[1]s. %[2]s Bonne chance pour la prochaine fois !"},
+		"game.reason.resigned": {Other: "%[1]s a abandonné."},
+		"game.reason.pieces":   {Other: "%[1]s n'a plus de pièces."},
+		"game.reason.moves":    {Other: "%[1]s n'a plus de coups."},
+		"game.reason.time":     {Other: "%[1]s n'a plus de temps."},
+		"move.sent.title":      {Other: "Coup joué !"},
+		"move.sent":            {Other: "Attendez ici que l'adversaire joue son coup."},
+		"move.sent.web":        {Other: "Vous avez joué %[1]s sur le web. Attendez ici que l'adversaire joue son coup."},
+
+		// Invites
+		"invite.title":            {Other: "Invitation à une partie de dames de %[1]s"},
+		"invite.direct":           {Other: "Cliquez sur  ✅  pour accepter cette invitation, ou sur  ❌  pour la refuser."},
+		"invite.general":          {Other: "Cliquez sur  ✅  pour accepter cette invitation."},
+		"invite.variant":          {Other: "Variante : %[1]s"},
+		"invite.ballot":           {Other: "Ouverture tirée au sort : %[1]s"},
+		"invite.casual":           {Other: "Partie amicale avec indices"},
+		"invite.time":             {Other: "Cadence : %[1]s"},
+		"invite.color":            {Other: "Vous jouerez les %[1]s."},
+		"invite.sent.title":       {Other: "Invitation envoyée"},
+		"invite.sent":             {Other: "Invitation envoyée à %[1]s ! Vous jouerez les %[2]s."},
+		"invite.accepted.title":   {Other: "Invitation acceptée !"},
+		"invite.accepted":         {Other: "Invitation de %[1]s acceptée !"},
+		"invite.declined.title":   {Other: "Invitation refusée"},
+		"invite.declined":         {Other: "Invitation de %[1]s refusée."},
+		"invite.refused.title":    {Other: "Invitation refusée"},
+		"invite.refused":          {Other: "%[1]s a refusé votre invitation."},
+		"invite.error.option":     {Other: "Option invalide"},
+		"invite.error.variant":    {Other: "Variante inconnue"},
+		"invite.error.ballot":     {Other: "Les ouvertures tirées au sort ne se jouent qu'aux dames anglaises"},
+		"invite.error.options":    {Other: "Options invalides"},
+		"invite.error.help":       {Other: "%[1]s. Pour la liste des options tapez `%[2]s help invites`"},
+		"invite.error.recipient":  {Other: "Destinataire invalide"},
+		"invite.error.yourself":   {Other: "Impossible de jouer contre vous-même !"},
+		"invite.error.bot":        {Other: "Impossible de jouer contre un bot !"},
+		"invite.error.mention":    {Other: "Mentionnez le joueur sous la forme @<utilisateur>. Pour une invitation ouverte à tous, ne mentionnez personne."},
+		"invite.error.invalid":    {Other: "Invitation invalide"},
+		"invite.error.multiple":   {Other: "Impossible d'inviter plusieurs joueurs !"},
+		"invite.error.ai":         {Other: "Les parties contre le bot sont désactivées sur ce serveur."},
+		"invite.error.dm":         {Other: "Impossible d'envoyer une invitation en message privé"},
+		"invite.error.channels":   {Other: "Les invitations ne peuvent être envoyées que dans %[1]s"},
+		"invite.error.send":       {Other: "Impossible d'envoyer l'invitation."},
+		"invite.error.ballot.bot": {Other: "Impossible de jouer l'ouverture tirée au sort."},
+
+		// Language command
+		"language.title":       {Other: "🗣️  Langue : %[1]s"},
+		"language.description": {Other: "Changez-la avec `%[1]s language <code>`, ou `%[1]s language auto` pour utiliser la langue du serveur. Langues disponibles : %[2]s"},
+		"language.error.title": {Other: "Langue invalide"},
+		"language.error":       {Other: "Langues disponibles : %[1]s"},
+		"language.error.save":  {Other: "Impossible d'enregistrer votre langue."},
+
+		// Accessible mode
+		"accessible.red":         {Other: "Pions rouges : %[1]s. Dames rouges : %[2]s."},
+		"accessible.blue":        {Other: "Pions bleus : %[1]s. Dames bleues : %[2]s."},
+		"accessible.none":        {Other: "aucun"},
+		"accessible.move":        {Other: "%[1]s vers %[2]s"},
+		"accessible.capture":     {One: "%[2]s saute vers %[3]s et prend %[1]d pièce", Other: "%[2]s saute vers %[3]s et prend %[1]d pièces"},
+		"accessible.last":        {Other: "Dernier coup : %[1]s."},
+		"accessible.play":        {Other: "Tapez `%[1]s play <numéro>` pour jouer un des coups ci-dessous."},
+		"accessible.on.title":    {Other: "Mode accessible activé"},
+		"accessible.on":          {Other: "Les plateaux sont décrits en mots et vos coups possibles sont numérotés, tapez `%[1]s play <numéro>` dans le message privé de la partie pour en jouer un. `%[1]s accessible off` réaffiche les plateaux en emojis."},
+		"accessible.off.title":   {Other: "Mode accessible désactivé"},
+		"accessible.off":         {Other: "Les plateaux sont de nouveau affichés en emojis."},
+		"accessible.error.title": {Other: "Option invalide"},
+		"accessible.error":       {Other: "Utilisez `%[1]s accessible on` ou `%[1]s accessible off`."},
+		"accessible.error.save":  {Other: "Impossible d'enregistrer votre réglage."},
+		"play.error.title":       {Other: "Impossible de jouer"},
+		"play.error.turn":        {Other: "Aucun plateau n'attend votre coup dans ce salon."},
+		"play.error.number":      {Other: "Choisissez un des coups numérotés, de 1 à %[1]d."},
+		"play.error.game":        {Other: "Cette partie ne peut se jouer qu'avec des réactions."},
+
+		// Commands
+		"command.missing.title": {Other: "Commande manquante"},
+		"command.missing":       {Other: "Pour la liste des commandes tapez %[1]s help"},
+		"command.invalid.title": {Other: "Commande invalide"},
+		"command.invalid":       {Other: "Pour la liste des sujets d'aide, tapez %[1]s help"},
+		"command.ping":          {Other: "Pong ! Actions en attente : %[1]d, latence moyenne : %[2]s"},
+
+		// Games against the bot
+		"ai.error.start": {Other: "Impossible de commencer la partie."},
+		"ai.start":       {Other: "Regardez vos messages privés, vous jouez les %[1]s contre le bot."},
+		"ai.start.wait":  {Other: "Vous jouez les %[1]s. Attendez ici que le bot joue son coup."},
+
+		// Web board
+		"web.stale.title":       {Other: "La partie a continué"},
+		"web.stale":             {Other: "Ce plateau n'est plus à jour car la partie a continué sur le web. Utilisez le plateau le plus récent."},
+		"web.error.title":       {Other: "Plateau web indisponible"},
+		"web.error":             {Other: "Ce bot n'a pas de plateau web."},
+		"web.error.games.title": {Other: "Aucune partie"},
+		"web.error.games":       {Other: "Vous ne jouez aucune partie en ce moment."},
+		"web.link":              {Other: "Contre %[1]s avec les %[2]s : %[3]s"},
+		"web.title":             {Other: "Vos parties sur le web"},
+		"web.links":             {Other: "Ne partagez pas ces liens, n'importe qui peut jouer à votre place avec.\n%[1]s"},
+		"web.sent.title":        {Other: "Liens envoyés !"},
+		"web.sent":              {Other: "Les liens de vos parties ont été envoyés en message privé."},
+
+		// Puzzles
+		"puzzle.description":       {Other: "%[1]s : les %[2]s jouent et gagnent. Classement : %[3]d"},
+		"puzzle.rating.title":      {Other: "Classement des problèmes"},
+		"puzzle.rating":            {Other: "%[1]s a un classement de %[2]d aux problèmes. Réussis : %[3]d, ratés : %[4]d."},
+		"puzzle.rating.change":     {Other: "Votre classement aux problèmes est maintenant de %[1]d (%[2]s)."},
+		"puzzle.error.title":       {Other: "Problème invalide"},
+		"puzzle.error":             {Other: "Il n'y a pas de problème n°%[1]d."},
+		"puzzle.error.setup":       {Other: "Impossible de préparer le problème."},
+		"puzzle.error.send":        {Other: "Erreur lors de l'envoi du problème."},
+		"puzzle.error.defence":     {Other: "Impossible de jouer la défense."},
+		"puzzle.done.title":        {Other: "Terminé !"},
+		"puzzle.done":              {Other: "Vous avez essayé tous les problèmes. Tapez `%[1]s puzzle <numéro>` pour en refaire un."},
+		"puzzle.sent.title":        {Other: "Problème envoyé !"},
+		"puzzle.sent":              {Other: "Le problème n°%[1]d a été envoyé en message privé."},
+		"puzzle.correct.title":     {Other: "Correct !"},
+		"puzzle.correct":           {Other: "Le bot a répondu %[1]s. Continuez !"},
+		"puzzle.solved.title":      {Other: "Problème résolu !"},
+		"puzzle.solved":            {Other: "Bravo, vous avez trouvé tous les coups du problème n°%[1]d."},
+		"puzzle.failed.title":      {Other: "Pas tout à fait"},
+		"puzzle.failed":            {Other: "La solution était %[1]s."},
+		"puzzle.next":              {Other: "Tapez `%[1]s puzzle` pour en faire un autre."},
+		"puzzle.daily.title":       {Other: "🧩 Problème du jour - %[1]s"},
+		"puzzle.daily.solve.title": {Other: "Résolvez-le"},
+		"puzzle.daily.solve":       {Other: "Tapez `%[1]s puzzle daily` et le bot vous l'enverra en message privé."},
+
+		// Board themes
+		"theme.title":              {Other: "🎨 Thème du plateau : %[1]s"},
+		"theme.pieces":             {Other: "%[1]s : pions %[2]s, dames %[3]s"},
+		"theme.images.title":       {Other: "Images"},
+		"theme.images.on":          {Other: "Activées"},
+		"theme.images.off":         {Other: "Désactivées"},
+		"theme.images.web":         {Other: "Activées, mais ce bot n'a pas de plateau web donc les plateaux sont toujours envoyés en emojis"},
+		"theme.change.title":       {Other: "Le changer"},
+		"theme.change":             {Other: "`%[1]s theme <nom>` avec l'un de %[2]s\n`%[1]s theme images on` affiche les plateaux en images"},
+		"theme.error.title":        {Other: "Thème invalide"},
+		"theme.error":              {Other: "Thèmes disponibles : %[1]s"},
+		"theme.error.images.title": {Other: "Option invalide"},
+		"theme.error.images":       {Other: "Utilisez `%[1]s theme images on` ou `%[1]s theme images off`."},
+		"theme.error.custom.title": {Other: "Pas d'emojis personnalisés"},
+		"theme.error.custom":       {Other: "Utilisez ceci dans un serveur qui a choisi ses emojis avec `%[1]s config emojis`."},
+		"theme.error.save":         {Other: "Impossible d'enregistrer votre thème."},
+
+		// Server settings
+		"config.title":                  {Other: "⚙️  Paramètres du serveur"},
+		"config.description":            {Other: "Changez un paramètre avec `%[1]s config <paramètre> <valeur>`, seuls les membres qui peuvent gérer le serveur peuvent les changer."},
+		"config.none":                   {Other: "Aucun"},
+		"config.on":                     {Other: "Activées"},
+		"config.off":                    {Other: "Désactivées"},
+		"config.prefix.title":           {Other: "Préfixe"},
+		"config.prefix":                 {Other: "`%[1]s`\n`%[1]s config prefix <préfixe>`"},
+		"config.invites.title":          {Other: "Salons des invitations"},
+		"config.invites":                {Other: "%[1]s\n`%[2]s config invites <#salon>...` ou `all`"},
+		"config.invites.all":            {Other: "Tous les salons"},
+		"config.variant.title":          {Other: "Variante par défaut"},
+		"config.variant":                {Other: "%[1]s\n`%[2]s config variant <nom>`"},
+		"config.time.title":             {Other: "Cadence"},
+		"config.time":                   {Other: "%[1]s\n`%[2]s config time <minutes+incrément>` ou `off`"},
+		"config.time.off":               {Other: "Sans limite de temps"},
+		"config.spectators.title":       {Other: "Salon des spectateurs"},
+		"config.spectators":             {Other: "%[1]s\n`%[2]s config spectators <#salon>` ou `off`"},
+		"config.reports.title":          {Other: "Salon des analyses"},
+		"config.reports":                {Other: "%[1]s\n`%[2]s config reports <#salon>` ou `off`"},
+		"config.ai.title":               {Other: "Parties contre le bot"},
+		"config.ai":                     {Other: "%[1]s\n`%[2]s config ai on` ou `off`"},
+		"config.theme.title":            {Other: "Thème du plateau"},
+		"config.theme":                  {Other: "%[1]s\n`%[2]s config theme <nom>`"},
+		"config.emojis.title":           {Other: "Emojis personnalisés"},
+		"config.emojis":                 {Other: "%[1]s\n`%[2]s config emojis <pion bleu> <pion rouge> <dame bleue> <dame rouge>`, les membres les choisissent avec `%[2]s theme custom`"},
+		"config.language.title":         {Other: "Langue"},
+		"config.language":               {Other: "%[1]s\n`%[2]s config language <code>` avec l'un de %[3]s, les membres choisissent la leur avec `%[2]s language`"},
+		"config.error.title":            {Other: "Paramètre invalide"},
+		"config.error":                  {Other: "%[1]s. Tapez `%[2]s config` pour voir tous les paramètres."},
+		"config.error.value":            {Other: "Valeur manquante"},
+		"config.error.setting":          {Other: "Paramètre inconnu"},
+		"config.error.prefix":           {Other: "Le préfixe ne peut pas contenir d'espaces"},
+		"config.error.channels":         {Other: "Mentionnez les salons comme #salon"},
+		"config.error.channel":          {Other: "Mentionnez le salon comme #salon"},
+		"config.error.variant":          {Other: "Variante inconnue. Variantes disponibles : %[1]s"},
+		"config.error.time":             {Other: "Cadence invalide, utilisez minutes+incrément comme 10+5"},
+		"config.error.ai":               {Other: "Utilisez on ou off"},
+		"config.error.theme":            {Other: "Thèmes disponibles : %[1]s"},
+		"config.error.emojis":           {Other: "Donnez quatre emojis personnalisés"},
+		"config.error.emoji":            {Other: "Seuls les emojis personnalisés d'un serveur peuvent être utilisés"},
+		"config.error.language":         {Other: "Langues disponibles : %[1]s"},
+		"config.error.guild":            {Other: "Les paramètres ne peuvent être changés que dans un serveur"},
+		"config.error.permissions":      {Other: "Impossible de vérifier vos permissions."},
+		"config.error.permission.title": {Other: "Permission manquante"},
+		"config.error.permission":       {Other: "Seuls les membres qui peuvent gérer le serveur peuvent changer ses paramètres."},
+		"config.error.save":             {Other: "Impossible d'enregistrer les paramètres."},
+
+		// Selection
+		"select.error.title": {Other: "Réactions invalides"},
+		"select.error":       {Other: "Vérifiez que vous avez réagi avec 1 lettre et 1 chiffre. Modifiez vos réactions et réessayez."},
+		"select.error.retry": {Other: "Modifiez vos réactions et réessayez."},
+
+		// Hints and analysis
+		"hint.title":                    {Other: "💡 Indice"},
+		"hint.select":                   {Other: "Sélectionnez %[1]s %[2]s et déplacez la pièce vers la 🎯"},
+		"hint.jump":                     {Other: "Continuez à sauter vers la 🎯"},
+		"hint.move":                     {Other: "Coup : %[1]s"},
+		"hint.on.title":                 {Other: "Indices activés"},
+		"hint.off.title":                {Other: "Indices désactivés"},
+		"hint.changed":                  {Other: "Cela s'applique au reste de la partie."},
+		"hint.off":                      {Other: "Tapez `%[1]s hint on` pour réactiver les indices dans cette partie."},
+		"hint.error.title":              {Other: "Pas d'indice"},
+		"hint.error.game":               {Other: "Pas de partie"},
+		"hint.error.casual.title":       {Other: "Indices interdits"},
+		"hint.error.casual":             {Other: "Les indices ne sont disponibles que dans les parties amicales. Ajoutez `casual` à une invitation pour en commencer une."},
+		"hint.error.turn.title":         {Other: "Pas votre tour"},
+		"hint.error.turn":               {Other: "Attendez que votre adversaire joue."},
+		"hint.error.option.title":       {Other: "Option invalide"},
+		"hint.error.option":             {Other: "Utilisez `%[1]s hint on` ou `%[1]s hint off` pour activer ou désactiver les indices."},
+		"hint.error.update":             {Other: "Impossible de mettre à jour la partie."},
+		"analysis.title":                {Other: "🔍 Analyse - %[1]s"},
+		"analysis.description":          {One: "%[2]s au trait, recherche sur %[1]d demi-coup.\nFEN : `%[3]s`", Other: "%[2]s au trait, recherche sur %[1]d demi-coups.\nFEN : `%[3]s`"},
+		"analysis.score.win":            {One: "Gagne en %[1]d demi-coup", Other: "Gagne en %[1]d demi-coups"},
+		"analysis.score.loss":           {One: "Perd en %[1]d demi-coup", Other: "Perd en %[1]d demi-coups"},
+		"analysis.error.title":          {Other: "Analyse impossible"},
+		"analysis.error.variant.title":  {Other: "Variante invalide"},
+		"analysis.error.variant":        {Other: "Variantes disponibles : %[1]s"},
+		"analysis.error.position.title": {Other: "Position manquante"},
+		"analysis.error.position":       {Other: "Tapez `%[1]s analyze <FEN>` pour analyser une position. Pour de l'aide tapez `%[1]s help analysis`"},
+		"analysis.error.fen.title":      {Other: "Position invalide"},
+
+		// Game reports
+		"report.analyzing":        {Other: "📊 Analyse de la partie, le rapport sera publié ici sous peu..."},
+		"report.error":            {Other: "Impossible d'analyser la partie."},
+		"report.title":            {Other: "📊 Rapport de partie - %[1]s"},
+		"report.summary":          {Other: "%[1]s %[2]s : %[3]s, %[4]s, %[5]s"},
+		"report.blunders":         {One: "%[1]d gaffe", Other: "%[1]d gaffes"},
+		"report.mistakes":         {One: "%[1]d erreur", Other: "%[1]d erreurs"},
+		"report.inaccuracies":     {One: "%[1]d imprécision", Other: "%[1]d imprécisions"},
+		"report.good":             {Other: "Bon coup"},
+		"report.inaccuracy":       {Other: "Imprécision"},
+		"report.mistake":          {Other: "Erreur"},
+		"report.blunder":          {Other: "Gaffe"},
+		"report.judgement":        {Other: "%[1]s, %[2]s était meilleur (%[3]s au lieu de %[4]s)"},
+		"report.evaluation.title": {Other: "Évaluation"},
+		"report.evaluation":       {Other: "Au-dessus de la ligne, l'avantage est aux %[1]s."},
+		"report.moments.title":    {Other: "Moments clés"},
+		"report.moments.none":     {Other: "Aucune imprécision, erreur ou gaffe trouvée."},
+		"report.moments.more":     {One: "...et %[1]d de plus dans le PDN", Other: "...et %[1]d de plus dans le PDN"},
+
+		// Adjudication
+		"adjudicate.title":         {Other: "Arbitrage"},
+		"adjudicate.error.title":   {Other: "Arbitrage impossible"},
+		"adjudicate.error.capture": {Other: "Terminez la prise avant d'arbitrer la partie."},
+		"adjudicate.error.variant": {Other: "Il n'y a pas de base de finales pour %[1]s."},
+		"adjudicate.error.pieces":  {One: "Il reste %[1]d pièce, la base de finales ne connaît que les positions jusqu'à %[2]d pièces.", Other: "Il reste %[1]d pièces, la base de finales ne connaît que les positions jusqu'à %[2]d pièces."},
+		"adjudicate.win":           {One: "%[2]s gagnent avec un jeu parfait en %[1]d demi-coup.", Other: "%[2]s gagnent avec un jeu parfait en %[1]d demi-coups."},
+		"adjudicate.draw":          {Other: "Aucun joueur ne peut forcer la victoire, la partie est nulle avec un jeu parfait."},
+
+		// Spectators and stats
+		"spectate.title": {Other: "👀 Partie de %[1]s"},
+		"spectate.turn":  {Other: "Au tour des %[1]s"},
+		"spectate.last":  {Other: "Dernier coup"},
+		"spectate.won":   {Other: "Les %[1]s ont gagné. %[2]s"},
+		"stats.title":    {Other: "Statistiques de %[1]s"},
+		"stats":          {Other: "Parties jouées : %[1]d\nGagnées : %[2]d\nPerdues : %[3]d"},
+
+		// Help
+		"help.invites.title":          {Other: "✉️  Invitations - Aide des dames"},
+		"help.invites":                {Other: "Les invitations permettent de commencer une partie avec un joueur. Les invitations NE PEUVENT PAS être envoyées :\n  • En message privé\n  • Par ou à des bots\n  • À vous-même\nVoir ci-dessous les commandes disponibles."},
+		"help.invites.general.title":  {Other: "Invitations ouvertes"},
+		"help.invites.general":        {Other: "`%[1]s invite` : Envoie dans le salon de la commande une invitation que n'importe qui peut accepter"},
+		"help.invites.direct.title":   {Other: "Invitations directes"},
+		"help.invites.direct":         {Other: "`%[1]s invite @<utilisateur>` : Envoie une invitation directement à l'utilisateur mentionné."},
+		"help.invites.colors.title":   {Other: "Couleurs"},
+		"help.invites.colors":         {Other: "Ajoutez `red`, `blue` ou `random` à une invitation pour choisir votre couleur. Les rouges jouent toujours en premier. Sans couleur vous jouez les bleus."},
+		"help.invites.ballots.title":  {Other: "Ouvertures tirées au sort"},
+		"help.invites.ballots":        {Other: "Ajoutez `ballot` à une invitation pour commencer une partie de dames anglaises à partir d'une ouverture de trois coups tirée au sort, comme en compétition."},
+		"help.invites.casual.title":   {Other: "Parties amicales"},
+		"help.invites.casual":         {Other: "Ajoutez `casual` à une invitation pour jouer une partie amicale. Les joueurs peuvent demander des indices au bot dans les parties amicales."},
+		"help.invites.variants.title": {Other: "Variantes"},
+		"help.invites.variants":       {Other: "Ajoutez `variant:<nom>` à une invitation pour jouer une autre variante. Variantes disponibles : %[1]s. Sans variante vous jouez aux dames anglaises. Au `giveaway` le premier joueur qui perd toutes ses pièces ou se retrouve bloqué gagne."},
+
+		"help.select.title":         {Other: "⏺  Sélection - Aide des dames"},
+		"help.select":               {Other: "La sélection se fait avec des réactions. Les réactions valides sont ajoutées par le bot. Sélectionnez une pièce en réagissant avec les emojis correspondants."},
+		"help.select.example.title": {Other: "Exemple"},
+		"help.select.example":       {Other: "Pour sélectionner la pièce sur la case F1 réagissez avec  🇫  puis  1️⃣ ."},
+		"help.select.confirm.title": {Other: "Confirmation"},
+		"help.select.confirm":       {Other: "Confirmez une sélection en réagissant avec  ✅ . Cela valide votre sélection et montre les coups possibles de la pièce sur le plateau."},
+
+		"help.move.title":          {Other: "↗️  Déplacement - Aide des dames"},
+		"help.move":                {Other: "Les déplacements se font avec des réactions. Les réactions valides sont ajoutées par le bot. Les coups de la pièce sélectionnée sont montrés sur le plateau. Déplacez la pièce en réagissant avec l'emoji correspondant."},
+		"help.move.example.title":  {Other: "Exemple"},
+		"help.move.example":        {Other: "Pour déplacer la pièce sélectionnée sur la case au nord-est, réagissez avec  ↗️"},
+		"help.move.numbered.title": {Other: "Coups numérotés"},
+		"help.move.numbered":       {Other: "Quand une pièce peut avancer de plusieurs cases dans la même direction, comme les dames aux dames internationales, les coups sont numérotés. Réagissez avec le numéro affiché sur la case où aller."},
+		"help.move.cancel.title":   {Other: "Annuler"},
+		"help.move.cancel":         {Other: "Pour sélectionner une autre pièce, réagissez avec  ❌ . Cela vous ramène à l'étape de sélection."},
+
+		"help.analysis.title":         {Other: "🔍  Analyse - Aide des dames"},
+		"help.analysis":               {Other: "Le bot peut étudier des positions et suggérer les meilleurs coups."},
+		"help.analysis.hints.title":   {Other: "Indices"},
+		"help.analysis.hints":         {Other: "`%[1]s hint` : Montre le meilleur coup sur le plateau. Ne fonctionne qu'à votre tour dans les parties amicales, tapez-le dans le message privé de la partie."},
+		"help.analysis.off.title":     {Other: "Désactiver les indices"},
+		"help.analysis.off":           {Other: "`%[1]s hint off` : Désactive les indices pour le reste de la partie, `%[1]s hint on` les réactive."},
+		"help.analysis.analyze.title": {Other: "Analyser une position"},
+		"help.analysis.analyze":       {Other: "`%[1]s analyze <FEN>` : Montre les trois meilleurs coups d'une position écrite en FEN, par exemple `%[1]s analyze W:W21,22,K23:B1,2,3`. Ajoutez `variant:<nom>` pour analyser une position d'une autre variante."},
+
+		"help.puzzles.title":        {Other: "🧩  Problèmes - Aide des dames"},
+		"help.puzzles":              {Other: "Les problèmes sont des positions avec une seule façon de gagner. Le bot vous les envoie en message privé, jouez vos coups comme dans une partie normale et le bot joue l'autre camp."},
+		"help.puzzles.start.title":  {Other: "Commencer un problème"},
+		"help.puzzles.start":        {Other: "`%[1]s puzzle` : Envoie un problème proche de votre classement que vous n'avez pas encore essayé."},
+		"help.puzzles.daily.title":  {Other: "Problème du jour"},
+		"help.puzzles.daily":        {Other: "`%[1]s puzzle daily` : Envoie le problème du jour."},
+		"help.puzzles.choose.title": {Other: "Choisir un problème"},
+		"help.puzzles.choose":       {Other: "`%[1]s puzzle <numéro>` : Envoie le problème portant ce numéro."},
+		"help.puzzles.rating.title": {Other: "Classement"},
+		"help.puzzles.rating":       {Other: "`%[1]s puzzle rating` : Montre votre classement aux problèmes. Seul le premier essai de chaque problème change votre classement."},
+
+		"help.topics.title":            {Other: "ℹ️  Sujets - Aide des dames"},
+		"help.topics":                  {Other: "Choisissez un sujet ci-dessous pour obtenir de l'aide"},
+		"help.topics.invites.title":    {Other: "✉️  Invitations"},
+		"help.topics.invites":          {Other: "`%[1]s help invites` : Explique comment envoyer des invitations"},
+		"help.topics.select.title":     {Other: "⏺  Sélection"},
+		"help.topics.select":           {Other: "`%[1]s help select` : Explique comment sélectionner une pièce"},
+		"help.topics.move.title":       {Other: "↗️  Déplacement"},
+		"help.topics.move":             {Other: "`%[1]s help move` : Explique comment déplacer une pièce"},
+		"help.topics.analysis.title":   {Other: "🔍  Analyse"},
+		"help.topics.analysis":         {Other: "`%[1]s help analysis` : Explique les indices et l'analyse de positions"},
+		"help.topics.puzzles.title":    {Other: "🧩  Problèmes"},
+		"help.topics.puzzles":          {Other: "`%[1]s help puzzles` : Explique comment résoudre des problèmes"},
+		"help.topics.web.title":        {Other: "🌐  Plateau web"},
+		"help.topics.web":              {Other: "`%[1]s web` : Vous envoie des liens pour jouer vos parties dans un navigateur, les coups joués là-bas apparaissent ici aussi"},
+		"help.topics.theme.title":      {Other: "🎨  Thème du plateau"},
+		"help.topics.theme":            {Other: "`%[1]s theme <nom>` : Change l'apparence de vos plateaux, `shapes` et `contrast` ne dépendent pas des couleurs. `%[1]s theme images on` affiche les plateaux en images"},
+		"help.topics.language.title":   {Other: "🗣️  Langue"},
+		"help.topics.language":         {Other: "`%[1]s language <code>` : Change la langue dans laquelle le bot vous parle. Langues disponibles : %[2]s"},
+		"help.topics.accessible.title": {Other: "♿  Mode accessible"},
+		"help.topics.accessible":       {Other: "`%[1]s accessible on` : Décrit les plateaux en mots pour les lecteurs d'écran, les coups sont numérotés et joués avec `%[1]s play <numéro>`"},
+		"help.topics.config.title":     {Other: "⚙️  Paramètres"},
+		"help.topics.config":           {Other: "`%[1]s config` : Montre les paramètres du serveur, les membres qui peuvent gérer le serveur peuvent changer le préfixe, les salons d'invitation, la variante par défaut, la cadence, le salon des spectateurs, les parties contre le bot, le thème du plateau, les emojis personnalisés et la langue"},
+		"help.topics.stats.title":      {Other: "📊  Statistiques"},
+		"help.topics.stats":            {Other: "`%[1]s stats [@<utilisateur>]` : Montre combien de parties vous ou l'utilisateur mentionné avez gagnées et perdues"},
+		"help.topics.adjudicate.title": {Other: "⚖️  Arbitrage"},
+		"help.topics.adjudicate":       {Other: "`%[1]s adjudicate` : Cherche la partie de votre message privé dans la base de finales et indique qui gagne avec un jeu parfait"},
+	},
+}
@@ -0,0 +1,212 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// The language used when none was chosen, every message exists in it
+const DEFAULT = "en"
+
+// Plural forms a count can take
+const (
+	ONE   = iota // One item, and zero in languages that count it as one
+	FEW          // A few items, like 2 to 4 in russian
+	MANY         // Many items, like 5 to 20 in russian
+	OTHER        // Every other count
+)
+
+// A message in one language, messages without plural forms only have Other
+type Message struct {
+	One   string // The message for counts of the ONE form
+	Few   string // The message for counts of the FEW form
+	Many  string // The message for counts of the MANY form
+	Other string // The message for counts of the OTHER form, and for any form left empty
+}
+
+// Gets the message for a plural form
+func (m Message) form(f int) string {
+	var text string
+	switch f {
+	case ONE:
+		text = m.One
+	case FEW:
+		text = m.Few
+	case MANY:
+		text = m.Many
+	}
+	if text == "" {
+		return m.Other
+	}
+	return text
+}
+
+// Checks if a message has plural forms
+func (m Message) plural() bool {
+	return m.One != "" || m.Few != "" || m.Many != ""
+}
+
+// A language the bot can talk in
+type Language struct {
+	Code     string             // Code used to choose the language, ISO 639-1
+	Name     string             // Name of the language in itself
+	Plural   func(n int) int    // Gets the plural form of a count
+	Messages map[string]Message // Every message by ID
+}
+
+// Every supported language, the default one first
+var Languages = []Language{english, french, russian, portuguese}
+
+// Plural rule of languages where only one is singular
+func oneOther(n int) int {
+	if n == 1 {
+		return ONE
+	}
+	return OTHER
+}
+
+// Plural rule of languages where zero is singular too
+func zeroOneOther(n int) int {
+	if n == 0 || n == 1 {
+		return ONE
+	}
+	return OTHER
+}
+
+// Plural rule of russian, the form depends on the last digits
+func slavic(n int) int {
+	if n%10 == 1 && n%100 != 11 {
+		return ONE
+	}
+	if n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14) {
+		return FEW
+	}
+	return MANY
+}
+
+// Gets a language from its code
+func Get(code string) (Language, bool) {
+	for _, l := range Languages {
+		if strings.EqualFold(l.Code, code) {
+			return l, true
+		}
+	}
+	return Language{}, false
+}
+
+// Checks if a language is supported
+func Supported(code string) bool {
+	_, ok := Get(code)
+	return ok
+}
+
+// Gets the codes of every language
+func Codes() []string {
+	var codes []string
+	for _, l := range Languages {
+		codes = append(codes, l.Code)
+	}
+	return codes
+}
+
+// Gets the name of a language, the default language for unsupported codes
+func Name(code string) string {
+	if l, ok := Get(code); ok {
+		return l.Name
+	}
+	return Languages[0].Name
+}
+
+// Finds a message and the language it is in, missing messages fall back to the default language
+func lookup(code string, id string) (Message, Language, bool) {
+	if l, ok := Get(code); ok {
+		if m, ok := l.Messages[id]; ok {
+			return m, l, true
+		}
+	}
+	m, ok := Languages[0].Messages[id]
+	return m, Languages[0], ok
+}
+
+// Formats a message with its arguments, arguments are referred to by index like %[1]s
+func format(text string, args []interface{}) string {
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// Gets a message in a language, unknown messages are shown as their ID
+func T(code string, id string, args ...interface{}) string {
+	m, _, ok := lookup(code, id)
+	if !ok {
+		return id
+	}
+	return format(m.Other, args)
+}
+
+// Gets the plural form of a message for a count, the count is the first argument of the message
+func N(code string, id string, n int, args ...interface{}) string {
+	m, l, ok := lookup(code, id)
+	if !ok {
+		return id
+	}
+	return format(m.form(l.Plural(n)), append([]interface{}{n}, args...))
+}
+
+// Matches the arguments used by a message
+var verbs = regexp.MustCompile(`%\[\d+\][a-z]`)
+
+// Gets the arguments used by a message, sorted and without duplicates
+func arguments(text string) string {
+	found := verbs.FindAllString(text, -1)
+	sort.Strings(found)
+	var unique []string
+	for i, v := range found {
+		if i == 0 || v != found[i-1] {
+			unique = append(unique, v)
+		}
+	}
+	return strings.Join(unique, "")
+}
+
+// Checks that every language has every message of the default language with the same arguments
+func Validate() error {
+	base := Languages[0]
+	for _, l := range Languages {
+		for id, m := range base.Messages {
+			translated, ok := l.Messages[id]
+			if !ok {
+				return errors.New("Message " + id + " is missing in " + l.Name)
+			}
+
+			// Every form the language uses must be written and take the same arguments
+			used := map[int]bool{}
+			for n := 0; n < 200; n++ {
+				used[l.Plural(n)] = true
+			}
+			want := arguments(m.Other)
+			for f := range used {
+				text := translated.Other
+				if m.plural() {
+					text = translated.form(f)
+				}
+				if text == "" {
+					return errors.New("Message " + id + " is empty in " + l.Name)
+				}
+				if arguments(text) != want {
+					return errors.New("Message " + id + " has other arguments in " + l.Name)
+				}
+			}
+		}
+		for id := range l.Messages {
+			if _, ok := base.Messages[id]; !ok {
+				return errors.New("Message " + id + " of " + l.Name + " is not in " + base.Name)
+			}
+		}
+	}
+	return nil
+}
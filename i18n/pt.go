@@ -0,0 +1,419 @@
+package i18n
+
+// Messages in portuguese
+var portuguese = Language{
+	Code:   "pt",
+	Name:   "Português",
+	Plural: zeroOneOther,
+	Messages: map[string]Message{
+		// Colors, directions and variants
+		"color.blue":            {Other: "🔵 Azul"},
+		"color.red":             {Other: "🔴 Vermelho"},
+		"direction.nw":          {Other: "Noroeste"},
+		"direction.ne":          {Other: "Nordeste"},
+		"direction.sw":          {Other: "Sudoeste"},
+		"direction.se":          {Other: "Sudeste"},
+		"variant.american":      {Other: "Damas inglesas"},
+		"variant.international": {Other: "Damas internacionais"},
+		"variant.russian":       {Other: "Damas russas"},
+		"variant.brazilian":     {Other: "Damas brasileiras"},
+		"variant.italian":       {Other: "Damas italianas"},
+		"variant.spanish":       {Other: "Damas espanholas"},
+		"variant.giveaway":      {Other: "Damas perde-ganha"},
+
+		// Errors shared by every command
+		"error.bot":          {Other: "Erro do bot"},
+		"error.dm":           {Other: "Erro ao criar a mensagem direta."},
+		"error.opponent":     {Other: "Erro ao buscar o adversário"},
+		"error.channel":      {Other: "Erro ao buscar o canal."},
+		"error.invalid":      {Other: "Canal inválido"},
+		"error.dm.open":      {Other: "Não foi possível abrir uma mensagem direta com o adversário"},
+		"error.dm.send":      {Other: "Não foi possível enviar a mensagem ao adversário"},
+		"error.game.title":   {Other: "Erro ao buscar a partida"},
+		"error.game":         {Other: "Esta partida provavelmente expirou ou não existe."},
+		"error.deselect":     {Other: "Não foi possível desfazer a seleção da peça"},
+		"error.unknown":      {Other: "Algo deu errado, tente novamente."},
+		"error.messages":     {Other: "Não foi possível ler as mensagens deste canal."},
+		"error.channel.game": {Other: "Não há nenhuma partida neste canal."},
+		"error.stale":        {Other: "A partida mudou desde que este tabuleiro foi enviado."},
+		"error.turn":         {Other: "Não é a sua vez."},
+		"error.coordinates":  {Other: "Coordenadas inválidas"},
+		"error.empty":        {Other: "Não é possível selecionar uma casa vazia"},
+		"error.piece":        {Other: "Não é possível selecionar uma peça do adversário"},
+		"error.jump.same":    {Other: "É preciso continuar saltando com a mesma peça"},
+		"error.jump.other":   {Other: "Outra peça tem que capturar"},
+		"error.nowhere":      {Other: "Esta peça não tem para onde ir"},
+		"error.fen":          {Other: "Esta não é uma posição em FEN, como W:W21,22,K23:B1,2,3"},
+		"error.notation":     {Other: "Notação de lance inválida"},
+		"error.impossible":   {Other: "Lance impossível"},
+		"error.ambiguous":    {Other: "Lance ambíguo, escreva todas as casas"},
+		"error.variant":      {Other: "Variante desconhecida"},
+		"error.moves":        {Other: "O jogador da vez não tem lances."},
+
+		// Boards
+		"game.title":           {Other: "%[1]s contra %[2]s"},
+		"game.status":          {Other: "Situação"},
+		"game.status.turn":     {Other: "Sua vez"},
+		"game.status.waiting":  {Other: "Esperando o adversário..."},
+		"game.playing":         {Other: "Você joga com %[1]s"},
+		"game.pieces":          {Other: "Suas peças: %[1]s pedras, %[2]s damas"},
+		"game.opening":         {Other: "Abertura: %[1]s"},
+		"game.casual":          {Other: "Partida amistosa"},
+		"game.casual.hints":    {Other: "Partida amistosa, digite `%[1]s hint` para uma dica"},
+		"game.captured.red":    {One: "%[1]d peça vermelha capturada", Other: "%[1]d peças vermelhas capturadas"},
+		"game.captured.blue":   {One: "%[1]d peça azul capturada", Other: "%[1]d peças azuis capturadas"},
+		"game.none":            {Other: "Nenhuma"},
+		"game.board":           {Other: "Tabuleiro"},
+		"game.image":           {Other: "Veja a imagem abaixo"},
+		"game.moves":           {Other: "Lances"},
+		"game.move":            {Other: "%[1]s para %[2]s"},
+		"game.move.direction":  {Other: "%[1]s %[2]s para %[3]s"},
+		"game.help":            {Other: "Ajuda"},
+		"game.help.topic":      {Other: "Para ajuda digite `%[1]s help %[2]s`"},
+		"game.help.topics":     {Other: "Para ajuda digite `%[1]s help`"},
+		"game.clock":           {Other: "Tempo restante (%[1]s)"},
+		"game.puzzle.title":    {Other: "🧩 Problema nº %[1]d - %[2]s"},
+		"game.puzzle":          {Other: "Encontre os lances vencedores com %[1]s, o bot joga do outro lado."},
+		"game.start.title":     {Other: "Valendo!"},
+		"game.start.wait":      {Other: "Você joga com %[1]s. Espere aqui %[2]s fazer o lance."},
+		"game.start.accepted":  {Other: "%[1]s aceitou seu convite! Você joga com %[2]s. Espere aqui o primeiro lance do adversário."},
+		"game.over.title":      {Other: "Fim de jogo"},
+		"game.over":            {Other: "Esta partida já terminou."},
+		"game.won.title":       {Other: "🎉 VOCÊ VENCEU!!! 🏆"},
+		"game.won":             {Other: "Parabéns! Você venceu a partida contra %[1]s. %[2]s"},
+		"game.lost.title":      {Other: "❌ Você perdeu. ❌"},
+		"game.lost":            {Other: "Você perdeu a partida contra %[1]s. %[2]s Mais sorte da próxima vez!"},
+		"game.reason.resigned": {Other: "%[1]s desistiu."},
+		"game.reason.pieces":   {Other: "%[1]s ficou sem peças."},
+		"game.reason.moves":    {Other: "%[1]s ficou sem lances."},
+		"game.reason.time":     {Other: "%[1]s ficou sem tempo."},
+		"move.sent.title":      {Other: "Lance enviado!"},
+		"move.sent":            {Other: "Espere aqui o lance do adversário."},
+		"move.sent.web":        {Other: "Você jogou %[1]s na web. Espere aqui o lance do adversário."},
+
+		// Invites
+		"invite.title":            {Other: "Convite para uma partida de damas de %[1]s"},
+		"invite.direct":           {Other: "Clique no  ✅  para aceitar este convite, ou no  ❌  para recusar."},
+		"invite.general":          {Other: "Clique no  ✅  para aceitar este convite."},
+		"invite.variant":          {Other: "Variante: %[1]s"},
+		"invite.ballot":           {Other: "Abertura sorteada: %[1]s"},
+		"invite.casual":           {Other: "Partida amistosa com dicas"},
+		"invite.time":             {Other: "Controle de tempo: %[1]s"},
+		"invite.color":            {Other: "Você vai jogar com %[1]s."},
+		"invite.sent.title":       {Other: "Sucesso"},
+		"invite.sent":             {Other: "Convite enviado para %[1]s! Você vai jogar com %[2]s."},
+		"invite.accepted.title":   {Other: "Convite aceito!"},
+		"invite.accepted":         {Other: "Convite de %[1]s aceito!"},
+		"invite.declined.title":   {Other: "Convite recusado"},
+		"invite.declined":         {Other: "Convite de %[1]s recusado."},
+		"invite.refused.title":    {Other: "Convite recusado"},
+		"invite.refused":          {Other: "%[1]s recusou seu convite."},
+		"invite.error.option":     {Other: "Opção inválida"},
+		"invite.error.variant":    {Other: "Variante desconhecida"},
+		"invite.error.ballot":     {Other: "Aberturas sorteadas só são jogadas nas damas inglesas"},
+		"invite.error.options":    {Other: "Opções inválidas"},
+		"invite.error.help":       {Other: "%[1]s. Para a lista de opções digite `%[2]s help invites`"},
+		"invite.error.recipient":  {Other: "Destinatário inválido"},
+		"invite.error.yourself":   {Other: "Não é possível jogar contra você mesmo!"},
+		"invite.error.bot":        {Other: "Não é possível jogar contra um bot!"},
+		"invite.error.mention":    {Other: "Mencione o jogador no formato @<usuário>. Para um convite aberto a todos, não mencione ninguém."},
+		"invite.error.invalid":    {Other: "Convite inválido"},
+		"invite.error.multiple":   {Other: "Não é possível convidar vários jogadores!"},
+		"invite.error.ai":         {Other: "Partidas contra o bot estão desativadas neste servidor."},
+		"invite.error.dm":         {Other: "Não é possível enviar convites por mensagem direta"},
+		"invite.error.channels":   {Other: "Convites só podem ser enviados em %[1]s"},
+		"invite.error.send":       {Other: "Erro ao enviar o convite."},
+		"invite.error.ballot.bot": {Other: "Não foi possível jogar a abertura sorteada."},
+
+		// Language command
+		"language.title":       {Other: "🗣️  Idioma: %[1]s"},
+		"language.description": {Other: "Mude com `%[1]s language <código>`, ou `%[1]s language auto` para usar o idioma do servidor. Idiomas disponíveis: %[2]s"},
+		"language.error.title": {Other: "Idioma inválido"},
+		"language.error":       {Other: "Idiomas disponíveis: %[1]s"},
+		"language.error.save":  {Other: "Não foi possível salvar seu idioma."},
+
+		// Accessible mode
+		"accessible.red":         {Other: "Pedras vermelhas: %[1]s. Damas vermelhas: %[2]s."},
+		"accessible.blue":        {Other: "Pedras azuis: %[1]s. Damas azuis: %[2]s."},
+		"accessible.none":        {Other: "nenhuma"},
+		"accessible.move":        {Other: "%[1]s para %[2]s"},
+		"accessible.capture":     {One: "%[2]s salta para %[3]s, capturando %[1]d peça", Other: "%[2]s salta para %[3]s, capturando %[1]d peças"},
+		"accessible.last":        {Other: "Último lance: %[1]s."},
+		"accessible.play":        {Other: "Digite `%[1]s play <número>` para fazer um dos lances abaixo."},
+		"accessible.on.title":    {Other: "Modo acessível ativado"},
+		"accessible.on":          {Other: "Os tabuleiros são descritos em palavras e seus lances possíveis são numerados, digite `%[1]s play <número>` na mensagem direta da partida para fazer um. `%[1]s accessible off` volta a mostrar os tabuleiros em emojis."},
+		"accessible.off.title":   {Other: "Modo acessível desativado"},
+		"accessible.off":         {Other: "Os tabuleiros voltaram a ser mostrados em emojis."},
+		"accessible.error.title": {Other: "Opção inválida"},
+		"accessible.error":       {Other: "Use `%[1]s accessible on` ou `%[1]s accessible off`."},
+		"accessible.error.save":  {Other: "Não foi possível salvar sua configuração."},
+		"play.error.title":       {Other: "Não é possível jogar"},
+		"play.error.turn":        {Other: "Não há tabuleiro esperando seu lance neste canal."},
+		"play.error.number":      {Other: "Escolha um dos lances numerados, de 1 a %[1]d."},
+		"play.error.game":        {Other: "Esta partida só pode ser jogada com reações."},
+
+		// Commands
+		"command.missing.title": {Other: "Comando ausente"},
+		"command.missing":       {Other: "Para a lista de comandos digite %[1]s help"},
+		"command.invalid.title": {Other: "Comando inválido"},
+		"command.invalid":       {Other: "Para a lista de tópicos de ajuda, digite %[1]s help"},
+		"command.ping":          {Other: "Pong! Ações na fila: %[1]d, latência média: %[2]s"},
+
+		// Games against the bot
+		"ai.error.start": {Other: "Não foi possível começar a partida."},
+		"ai.start":       {Other: "Veja suas mensagens diretas, você joga com %[1]s contra o bot."},
+		"ai.start.wait":  {Other: "Você joga com %[1]s. Espere aqui o bot fazer o lance dele."},
+
+		// Web board
+		"web.stale.title":       {Other: "A partida continuou"},
+		"web.stale":             {Other: "Este tabuleiro está desatualizado porque a partida continuou na web. Use o tabuleiro mais recente."},
+		"web.error.title":       {Other: "Tabuleiro web indisponível"},
+		"web.error":             {Other: "Este bot não tem um tabuleiro web."},
+		"web.error.games.title": {Other: "Nenhuma partida"},
+		"web.error.games":       {Other: "Você não está jogando nenhuma partida agora."},
+		"web.link":              {Other: "Contra %[1]s com %[2]s: %[3]s"},
+		"web.title":             {Other: "Suas partidas na web"},
+		"web.links":             {Other: "Não compartilhe estes links, qualquer um com um deles pode jogar por você.\n%[1]s"},
+		"web.sent.title":        {Other: "Links enviados!"},
+		"web.sent":              {Other: "Os links das suas partidas foram enviados por mensagem direta."},
+
+		// Puzzles
+		"puzzle.description":       {Other: "%[1]s: vez de %[2]s, jogue e ganhe. Rating: %[3]d"},
+		"puzzle.rating.title":      {Other: "Rating de problemas"},
+		"puzzle.rating":            {Other: "%[1]s tem um rating de problemas de %[2]d. Resolvidos: %[3]d, errados: %[4]d."},
+		"puzzle.rating.change":     {Other: "Seu rating de problemas agora é %[1]d (%[2]s)."},
+		"puzzle.error.title":       {Other: "Problema inválido"},
+		"puzzle.error":             {Other: "Não existe o problema nº %[1]d."},
+		"puzzle.error.setup":       {Other: "Não foi possível preparar o problema."},
+		"puzzle.error.send":        {Other: "Erro ao enviar o problema."},
+		"puzzle.error.defence":     {Other: "Não foi possível jogar a defesa."},
+		"puzzle.done.title":        {Other: "Tudo feito!"},
+		"puzzle.done":              {Other: "Você já tentou todos os problemas. Digite `%[1]s puzzle <número>` para tentar um de novo."},
+		"puzzle.sent.title":        {Other: "Problema enviado!"},
+		"puzzle.sent":              {Other: "O problema nº %[1]d foi enviado por mensagem direta."},
+		"puzzle.correct.title":     {Other: "Correto!"},
+		"puzzle.correct":           {Other: "O bot respondeu com %[1]s. Continue!"},
+		"puzzle.solved.title":      {Other: "Problema resolvido!"},
+		"puzzle.solved":            {Other: "Muito bem, você encontrou todos os lances do problema nº %[1]d."},
+		"puzzle.failed.title":      {Other: "Não exatamente"},
+		"puzzle.failed":            {Other: "A solução era %[1]s."},
+		"puzzle.next":              {Other: "Digite `%[1]s puzzle` para outro."},
+		"puzzle.daily.title":       {Other: "🧩 Problema do dia - %[1]s"},
+		"puzzle.daily.solve.title": {Other: "Resolva"},
+		"puzzle.daily.solve":       {Other: "Digite `%[1]s puzzle daily` e o bot vai enviá-lo por mensagem direta."},
+
+		// Board themes
+		"theme.title":              {Other: "🎨 Tema do tabuleiro: %[1]s"},
+		"theme.pieces":             {Other: "%[1]s: pedras %[2]s, damas %[3]s"},
+		"theme.images.title":       {Other: "Imagens"},
+		"theme.images.on":          {Other: "Ligadas"},
+		"theme.images.off":         {Other: "Desligadas"},
+		"theme.images.web":         {Other: "Ligadas, mas este bot não tem um tabuleiro web então os tabuleiros ainda são enviados com emojis"},
+		"theme.change.title":       {Other: "Mudar"},
+		"theme.change":             {Other: "`%[1]s theme <nome>` com um de %[2]s\n`%[1]s theme images on` mostra os tabuleiros como imagens"},
+		"theme.error.title":        {Other: "Tema inválido"},
+		"theme.error":              {Other: "Temas disponíveis: %[1]s"},
+		"theme.error.images.title": {Other: "Opção inválida"},
+		"theme.error.images":       {Other: "Use `%[1]s theme images on` ou `%[1]s theme images off`."},
+		"theme.error.custom.title": {Other: "Sem emojis personalizados"},
+		"theme.error.custom":       {Other: "Use isto em um servidor que escolheu seus emojis com `%[1]s config emojis`."},
+		"theme.error.save":         {Other: "Não foi possível salvar seu tema."},
+
+		// Server settings
+		"config.title":                  {Other: "⚙️  Configurações do servidor"},
+		"config.description":            {Other: "Mude uma configuração com `%[1]s config <configuração> <valor>`, só membros que podem gerenciar o servidor podem mudá-las."},
+		"config.none":                   {Other: "Nenhum"},
+		"config.on":                     {Other: "Ligadas"},
+		"config.off":                    {Other: "Desligadas"},
+		"config.prefix.title":           {Other: "Prefixo"},
+		"config.prefix":                 {Other: "`%[1]s`\n`%[1]s config prefix <prefixo>`"},
+		"config.invites.title":          {Other: "Canais de convites"},
+		"config.invites":                {Other: "%[1]s\n`%[2]s config invites <#canal>...` ou `all`"},
+		"config.invites.all":            {Other: "Todos os canais"},
+		"config.variant.title":          {Other: "Variante padrão"},
+		"config.variant":                {Other: "%[1]s\n`%[2]s config variant <nome>`"},
+		"config.time.title":             {Other: "Controle de tempo"},
+		"config.time":                   {Other: "%[1]s\n`%[2]s config time <minutos+incremento>` ou `off`"},
+		"config.time.off":               {Other: "Sem limite de tempo"},
+		"config.spectators.title":       {Other: "Canal de espectadores"},
+		"config.spectators":             {Other: "%[1]s\n`%[2]s config spectators <#canal>` ou `off`"},
+		"config.reports.title":          {Other: "Canal de análises"},
+		"config.reports":                {Other: "%[1]s\n`%[2]s config reports <#canal>` ou `off`"},
+		"config.ai.title":               {Other: "Partidas contra o bot"},
+		"config.ai":                     {Other: "%[1]s\n`%[2]s config ai on` ou `off`"},
+		"config.theme.title":            {Other: "Tema do tabuleiro"},
+		"config.theme":                  {Other: "%[1]s\n`%[2]s config theme <nome>`"},
+		"config.emojis.title":           {Other: "Emojis personalizados"},
+		"config.emojis":                 {Other: "%[1]s\n`%[2]s config emojis <pedra azul> <pedra vermelha> <dama azul> <dama vermelha>`, os membros os escolhem com `%[2]s theme custom`"},
+		"config.language.title":         {Other: "Idioma"},
+		"config.language":               {Other: "%[1]s\n`%[2]s config language <código>` com um de %[3]s, os membros escolhem o seu com `%[2]s language`"},
+		"config.error.title":            {Other: "Configuração inválida"},
+		"config.error":                  {Other: "%[1]s. Digite `%[2]s config` para ver todas as configurações."},
+		"config.error.value":            {Other: "Valor ausente"},
+		"config.error.setting":          {Other: "Configuração desconhecida"},
+		"config.error.prefix":           {Other: "O prefixo não pode ter espaços"},
+		"config.error.channels":         {Other: "Mencione os canais como #canal"},
+		"config.error.channel":          {Other: "Mencione o canal como #canal"},
+		"config.error.variant":          {Other: "Variante desconhecida. Variantes disponíveis: %[1]s"},
+		"config.error.time":             {Other: "Controle de tempo inválido, use minutos+incremento como 10+5"},
+		"config.error.ai":               {Other: "Use on ou off"},
+		"config.error.theme":            {Other: "Temas disponíveis: %[1]s"},
+		"config.error.emojis":           {Other: "Dê quatro emojis personalizados"},
+		"config.error.emoji":            {Other: "Só emojis personalizados de um servidor podem ser usados"},
+		"config.error.language":         {Other: "Idiomas disponíveis: %[1]s"},
+		"config.error.guild":            {Other: "As configurações só podem ser mudadas em um servidor"},
+		"config.error.permissions":      {Other: "Não foi possível verificar suas permissões."},
+		"config.error.permission.title": {Other: "Permissão ausente"},
+		"config.error.permission":       {Other: "Só membros que podem gerenciar o servidor podem mudar as configurações dele."},
+		"config.error.save":             {Other: "Não foi possível salvar as configurações."},
+
+		// Selection
+		"select.error.title": {Other: "Reações inválidas"},
+		"select.error":       {Other: "Verifique se você reagiu com 1 letra e 1 número. Ajuste suas reações e tente novamente."},
+		"select.error.retry": {Other: "Ajuste suas reações e tente novamente."},
+
+		// Hints and analysis
+		"hint.title":                    {Other: "💡 Dica"},
+		"hint.select":                   {Other: "Selecione %[1]s %[2]s e mova a peça para o 🎯"},
+		"hint.jump":                     {Other: "Continue saltando para o 🎯"},
+		"hint.move":                     {Other: "Lance: %[1]s"},
+		"hint.on.title":                 {Other: "Dicas ativadas"},
+		"hint.off.title":                {Other: "Dicas desativadas"},
+		"hint.changed":                  {Other: "Isso vale para o resto da partida."},
+		"hint.off":                      {Other: "Digite `%[1]s hint on` para reativar as dicas nesta partida."},
+		"hint.error.title":              {Other: "Sem dica"},
+		"hint.error.game":               {Other: "Nenhuma partida"},
+		"hint.error.casual.title":       {Other: "Dicas não permitidas"},
+		"hint.error.casual":             {Other: "As dicas só podem ser usadas em partidas amistosas. Adicione `casual` a um convite para começar uma."},
+		"hint.error.turn.title":         {Other: "Não é a sua vez"},
+		"hint.error.turn":               {Other: "Espere seu adversário fazer o lance."},
+		"hint.error.option.title":       {Other: "Opção inválida"},
+		"hint.error.option":             {Other: "Use `%[1]s hint on` ou `%[1]s hint off` para ativar ou desativar as dicas."},
+		"hint.error.update":             {Other: "Não foi possível atualizar a partida."},
+		"analysis.title":                {Other: "🔍 Análise - %[1]s"},
+		"analysis.description":          {One: "%[2]s a jogar, busca de %[1]d meio-lance.\nFEN: `%[3]s`", Other: "%[2]s a jogar, busca de %[1]d meios-lances.\nFEN: `%[3]s`"},
+		"analysis.score.win":            {One: "Vence em %[1]d meio-lance", Other: "Vence em %[1]d meios-lances"},
+		"analysis.score.loss":           {One: "Perde em %[1]d meio-lance", Other: "Perde em %[1]d meios-lances"},
+		"analysis.error.title":          {Other: "Não é possível analisar"},
+		"analysis.error.variant.title":  {Other: "Variante inválida"},
+		"analysis.error.variant":        {Other: "Variantes disponíveis: %[1]s"},
+		"analysis.error.position.title": {Other: "Posição ausente"},
+		"analysis.error.position":       {Other: "Digite `%[1]s analyze <FEN>` para analisar uma posição. Para ajuda digite `%[1]s help analysis`"},
+		"analysis.error.fen.title":      {Other: "Posição inválida"},
+
+		// Game reports
+		"report.analyzing":        {Other: "📊 Analisando a partida, o relatório será publicado aqui em breve..."},
+		"report.error":            {Other: "Não foi possível analisar a partida."},
+		"report.title":            {Other: "📊 Relatório da partida - %[1]s"},
+		"report.summary":          {Other: "%[1]s %[2]s: %[3]s, %[4]s, %[5]s"},
+		"report.blunders":         {One: "%[1]d capivarada", Other: "%[1]d capivaradas"},
+		"report.mistakes":         {One: "%[1]d erro", Other: "%[1]d erros"},
+		"report.inaccuracies":     {One: "%[1]d imprecisão", Other: "%[1]d imprecisões"},
+		"report.good":             {Other: "Bom lance"},
+		"report.inaccuracy":       {Other: "Imprecisão"},
+		"report.mistake":          {Other: "Erro"},
+		"report.blunder":          {Other: "Capivarada"},
+		"report.judgement":        {Other: "%[1]s, %[2]s era melhor (%[3]s em vez de %[4]s)"},
+		"report.evaluation.title": {Other: "Avaliação"},
+		"report.evaluation":       {Other: "Acima da linha é bom para %[1]s."},
+		"report.moments.title":    {Other: "Momentos decisivos"},
+		"report.moments.none":     {Other: "Nenhuma imprecisão, erro ou capivarada encontrada."},
+		"report.moments.more":     {One: "...e mais %[1]d no PDN", Other: "...e mais %[1]d no PDN"},
+
+		// Adjudication
+		"adjudicate.title":         {Other: "Arbitragem"},
+		"adjudicate.error.title":   {Other: "Não é possível arbitrar"},
+		"adjudicate.error.capture": {Other: "Termine a captura antes de arbitrar a partida."},
+		"adjudicate.error.variant": {Other: "Não há base de finais para %[1]s."},
+		"adjudicate.error.pieces":  {One: "A partida tem %[1]d peça, a base de finais só conhece posições com até %[2]d peças.", Other: "A partida tem %[1]d peças, a base de finais só conhece posições com até %[2]d peças."},
+		"adjudicate.win":           {One: "%[2]s vencem com jogo perfeito em %[1]d meio-lance.", Other: "%[2]s vencem com jogo perfeito em %[1]d meios-lances."},
+		"adjudicate.draw":          {Other: "Nenhum jogador pode forçar a vitória, a partida é empate com jogo perfeito."},
+
+		// Spectators and stats
+		"spectate.title": {Other: "👀 Partida de %[1]s"},
+		"spectate.turn":  {Other: "Vez das %[1]s"},
+		"spectate.last":  {Other: "Último lance"},
+		"spectate.won":   {Other: "As %[1]s venceram. %[2]s"},
+		"stats.title":    {Other: "Estatísticas de %[1]s"},
+		"stats":          {Other: "Partidas jogadas: %[1]d\nVitórias: %[2]d\nDerrotas: %[3]d"},
+
+		// Help
+		"help.invites.title":          {Other: "✉️  Convites - Ajuda de damas"},
+		"help.invites":                {Other: "Convites permitem começar uma partida com um jogador. Convites NÃO PODEM ser enviados:\n  • Por mensagem direta\n  • Por ou para bots\n  • Para você mesmo\nVeja abaixo os comandos disponíveis."},
+		"help.invites.general.title":  {Other: "Convites abertos"},
+		"help.invites.general":        {Other: "`%[1]s invite`: Envia no canal do comando um convite que qualquer um pode aceitar"},
+		"help.invites.direct.title":   {Other: "Convites diretos"},
+		"help.invites.direct":         {Other: "`%[1]s invite @<usuário>`: Envia um convite diretamente ao usuário mencionado."},
+		"help.invites.colors.title":   {Other: "Cores"},
+		"help.invites.colors":         {Other: "Adicione `red`, `blue` ou `random` a um convite para escolher sua cor. O vermelho sempre começa. Sem cor você joga com o azul."},
+		"help.invites.ballots.title":  {Other: "Aberturas sorteadas"},
+		"help.invites.ballots":        {Other: "Adicione `ballot` a um convite para começar uma partida de damas inglesas a partir de uma abertura de três lances sorteada, como nas competições."},
+		"help.invites.casual.title":   {Other: "Partidas amistosas"},
+		"help.invites.casual":         {Other: "Adicione `casual` a um convite para jogar uma partida amistosa. Nas partidas amistosas os jogadores podem pedir dicas ao bot."},
+		"help.invites.variants.title": {Other: "Variantes"},
+		"help.invites.variants":       {Other: "Adicione `variant:<nome>` a um convite para jogar outra variante. Variantes disponíveis: %[1]s. Sem variante você joga damas inglesas. No `giveaway` vence quem primeiro perder todas as peças ou ficar bloqueado."},
+
+		"help.select.title":         {Other: "⏺  Seleção - Ajuda de damas"},
+		"help.select":               {Other: "A seleção é feita com reações. As reações válidas são colocadas pelo bot. Selecione uma peça reagindo com os emojis correspondentes."},
+		"help.select.example.title": {Other: "Exemplo"},
+		"help.select.example":       {Other: "Para selecionar a peça na casa F1 reaja com  🇫  e depois  1️⃣ ."},
+		"help.select.confirm.title": {Other: "Confirmação"},
+		"help.select.confirm":       {Other: "Confirme a seleção reagindo com  ✅ . Isso valida sua seleção e mostra no tabuleiro os lances possíveis da peça."},
+
+		"help.move.title":          {Other: "↗️  Movimento - Ajuda de damas"},
+		"help.move":                {Other: "Os movimentos são feitos com reações. As reações válidas são colocadas pelo bot. Os lances da peça selecionada aparecem no tabuleiro. Mova a peça reagindo com o emoji correspondente."},
+		"help.move.example.title":  {Other: "Exemplo"},
+		"help.move.example":        {Other: "Para mover a peça selecionada para a casa a nordeste dela, reaja com  ↗️"},
+		"help.move.numbered.title": {Other: "Lances numerados"},
+		"help.move.numbered":       {Other: "Quando uma peça pode andar mais de uma casa na mesma direção, como as damas nas damas internacionais, os lances são numerados. Reaja com o número mostrado na casa de destino."},
+		"help.move.cancel.title":   {Other: "Cancelar"},
+		"help.move.cancel":         {Other: "Para selecionar outra peça, reaja com  ❌ . Isso volta para a etapa de seleção."},
+
+		"help.analysis.title":         {Other: "🔍  Análise - Ajuda de damas"},
+		"help.analysis":               {Other: "O bot pode estudar posições e sugerir os melhores lances."},
+		"help.analysis.hints.title":   {Other: "Dicas"},
+		"help.analysis.hints":         {Other: "`%[1]s hint`: Mostra o melhor lance no tabuleiro. Só funciona na sua vez em partidas amistosas, digite na mensagem direta da partida."},
+		"help.analysis.off.title":     {Other: "Desativar dicas"},
+		"help.analysis.off":           {Other: "`%[1]s hint off`: Desativa as dicas pelo resto da partida, `%[1]s hint on` as reativa."},
+		"help.analysis.analyze.title": {Other: "Analisar uma posição"},
+		"help.analysis.analyze":       {Other: "`%[1]s analyze <FEN>`: Mostra os três melhores lances de uma posição escrita em FEN, por exemplo `%[1]s analyze W:W21,22,K23:B1,2,3`. Adicione `variant:<nome>` para analisar uma posição de outra variante."},
+
+		"help.puzzles.title":        {Other: "🧩  Problemas - Ajuda de damas"},
+		"help.puzzles":              {Other: "Problemas são posições com uma única forma de vencer. O bot os envia por mensagem direta, faça seus lances como numa partida normal e o bot joga do outro lado."},
+		"help.puzzles.start.title":  {Other: "Começar um problema"},
+		"help.puzzles.start":        {Other: "`%[1]s puzzle`: Envia um problema próximo da sua pontuação que você ainda não tentou."},
+		"help.puzzles.daily.title":  {Other: "Problema do dia"},
+		"help.puzzles.daily":        {Other: "`%[1]s puzzle daily`: Envia o problema do dia."},
+		"help.puzzles.choose.title": {Other: "Escolher um problema"},
+		"help.puzzles.choose":       {Other: "`%[1]s puzzle <número>`: Envia o problema com esse número."},
+		"help.puzzles.rating.title": {Other: "Pontuação"},
+		"help.puzzles.rating":       {Other: "`%[1]s puzzle rating`: Mostra sua pontuação em problemas. Só a primeira tentativa de cada problema muda sua pontuação."},
+
+		"help.topics.title":            {Other: "ℹ️  Tópicos - Ajuda de damas"},
+		"help.topics":                  {Other: "Escolha um tópico abaixo para obter ajuda"},
+		"help.topics.invites.title":    {Other: "✉️  Convites"},
+		"help.topics.invites":          {Other: "`%[1]s help invites`: Explica como enviar convites"},
+		"help.topics.select.title":     {Other: "⏺  Seleção"},
+		"help.topics.select":           {Other: "`%[1]s help select`: Explica como selecionar uma peça"},
+		"help.topics.move.title":       {Other: "↗️  Movimento"},
+		"help.topics.move":             {Other: "`%[1]s help move`: Explica como mover uma peça"},
+		"help.topics.analysis.title":   {Other: "🔍  Análise"},
+		"help.topics.analysis":         {Other: "`%[1]s help analysis`: Explica as dicas e a análise de posições"},
+		"help.topics.puzzles.title":    {Other: "🧩  Problemas"},
+		"help.topics.puzzles":          {Other: "`%[1]s help puzzles`: Explica como resolver problemas"},
+		"help.topics.web.title":        {Other: "🌐  Tabuleiro web"},
+		"help.topics.web":              {Other: "`%[1]s web`: Envia links para jogar suas partidas no navegador, os lances feitos lá aparecem aqui também"},
+		"help.topics.theme.title":      {Other: "🎨  Tema do tabuleiro"},
+		"help.topics.theme":            {Other: "`%[1]s theme <nome>`: Muda a aparência dos seus tabuleiros, `shapes` e `contrast` não dependem de cores. `%[1]s theme images on` mostra os tabuleiros como imagens"},
+		"help.topics.language.title":   {Other: "🗣️  Idioma"},
+		"help.topics.language":         {Other: "`%[1]s language <código>`: Muda o idioma em que o bot fala com você. Idiomas disponíveis: %[2]s"},
+		"help.topics.accessible.title": {Other: "♿  Modo acessível"},
+		"help.topics.accessible":       {Other: "`%[1]s accessible on`: Descreve os tabuleiros em palavras para leitores de tela, os lances são numerados e jogados com `%[1]s play <número>`"},
+		"help.topics.config.title":     {Other: "⚙️  Configurações"},
+		"help.topics.config":           {Other: "`%[1]s config`: Mostra as configurações do servidor, membros que podem gerenciar o servidor podem mudar o prefixo, os canais de convite, a variante padrão, o controle de tempo, o canal de espectadores, as partidas contra o bot, o tema do tabuleiro, os emojis personalizados e o idioma"},
+		"help.topics.stats.title":      {Other: "📊  Estatísticas"},
+		"help.topics.stats":            {Other: "`%[1]s stats [@<usuário>]`: Mostra quantas partidas você ou o usuário mencionado venceu e perdeu"},
+		"help.topics.adjudicate.title": {Other: "⚖️  Arbitragem"},
+		"help.topics.adjudicate":       {Other: "`%[1]s adjudicate`: Procura a partida da sua mensagem direta na base de finais e diz quem vence com jogo perfeito"},
+	},
+}
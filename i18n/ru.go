@@ -0,0 +1,479 @@
+package i18n
+
+// Messages in russian
+var russian = Language{
+	Code:   "ru",
+	Name:   "Русский",
+	Plural: slavic,
+	Messages: map[string]Message{
+		// Colors, directions and variants
+		"color.blue":            {Other: "🔵 Синие"},
+		"color.red":             {Other: "🔴 Красные"},
+		"direction.nw":          {Other: "Северо-запад"},
+		"direction.ne":          {Other: "Северо-восток"},
+		"direction.sw":          {Other: "Юго-запад"},
+		"direction.se":          {Other: "Юго-восток"},
+		"variant.american":      {Other: "Английские шашки"},
+		"variant.international": {Other: "Международные шашки"},
+		"variant.russian":       {Other: "Русские шашки"},
+		"variant.brazilian":     {Other: "Бразильские шашки"},
+		"variant.italian":       {Other: "Итальянские шашки"},
+		"variant.spanish":       {Other: "Испанские шашки"},
+		"variant.giveaway":      {Other: "Поддавки"},
+
+		// Errors shared by every command
+		"error.bot":          {Other: "Ошибка бота"},
+		"error.dm":           {Other: "Не удалось создать личное сообщение."},
+		"error.opponent":     {Other: "Не удалось найти соперника"},
+		"error.channel":      {Other: "Не удалось найти канал."},
+		"error.invalid":      {Other: "Неверный канал"},
+		"error.dm.open":      {Other: "Не удалось открыть личные сообщения с соперником"},
+		"error.dm.send":      {Other: "Не удалось отправить сообщение сопернику"},
+		"error.game.title":   {Other: "Не удалось найти партию"},
+		"error.game":         {Other: "Скорее всего, эта партия устарела или не существует."},
+		"error.deselect":     {Other: "Не удалось отменить выбор шашки"},
+		"error.unknown":      {Other: "Что-то пошло не так, попробуйте ещё раз."},
+		"error.messages":     {Other: "Не удалось получить сообщения этого канала."},
+		"error.channel.game": {Other: "В этом канале нет партии."},
+		"error.stale":        {Other: "Партия изменилась с тех пор, как была отправлена эта доска."},
+		"error.turn":         {Other: "Сейчас не ваш ход."},
+		"error.coordinates":  {Other: "Неверные координаты"},
+		"error.empty":        {Other: "Нельзя выбрать пустую клетку"},
+		"error.piece":        {Other: "Нельзя выбрать шашку соперника"},
+		"error.jump.same":    {Other: "Нужно продолжать бить той же шашкой"},
+		"error.jump.other":   {Other: "Бить должна другая шашка"},
+		"error.nowhere":      {Other: "Этой шашке некуда ходить"},
+		"error.fen":          {Other: "Это не позиция в FEN, например W:W21,22,K23:B1,2,3"},
+		"error.notation":     {Other: "Неверная запись хода"},
+		"error.impossible":   {Other: "Такой ход невозможен"},
+		"error.ambiguous":    {Other: "Ход неоднозначен, укажите каждую клетку"},
+		"error.variant":      {Other: "Неизвестный вариант"},
+		"error.moves":        {Other: "У игрока, чей ход, нет ходов."},
+
+		// Boards
+		"game.title":          {Other: "%[1]s: партия против %[2]s"},
+		"game.status":         {Other: "Статус"},
+		"game.status.turn":    {Other: "Ваш ход"},
+		"game.status.waiting": {Other: "Ожидание соперника..."},
+		"game.playing":        {Other: "Вы играете за %[1]s"},
+		"game.pieces":         {Other: "Ваши фигуры: %[1]s шашки, %[2]s дамки"},
+		"game.opening":        {Other: "Дебют: %[1]s"},
+		"game.casual":         {Other: "Товарищеская партия"},
+		"game.casual.hints":   {Other: "Товарищеская партия, напишите `%[1]s hint` для подсказки"},
+		"game.captured.red": {
+			One:   "%[1]d красная шашка взята",
+			Few:   "%[1]d красные шашки взяты",
+			Many:  "%[1]d красных шашек взято",
+			Other: "%[1]d красных шашек взято",
+		},
+		"game.captured.blue": {
+			One:   "%[1]d синяя шашка взята",
+			Few:   "%[1]d синие шашки взяты",
+			Many:  "%[1]d синих шашек взято",
+			Other: "%[1]d синих шашек взято",
+		},
+		"game.none":            {Other: "Нет"},
+		"game.board":           {Other: "Доска"},
+		"game.image":           {Other: "Смотрите изображение ниже"},
+		"game.moves":           {Other: "Ходы"},
+		"game.move":            {Other: "%[1]s на %[2]s"},
+		"game.move.direction":  {Other: "%[1]s %[2]s на %[3]s"},
+		"game.help":            {Other: "Помощь"},
+		"game.help.topic":      {Other: "Для помощи напишите `%[1]s help %[2]s`"},
+		"game.help.topics":     {Other: "Для помощи напишите `%[1]s help`"},
+		"game.clock":           {Other: "Осталось времени (%[1]s)"},
+		"game.puzzle.title":    {Other: "🧩 Задача №%[1]d - %[2]s"},
+		"game.puzzle":          {Other: "Найдите выигрывающие ходы за %[1]s, бот играет за другую сторону."},
+		"game.start.title":     {Other: "Игра началась!"},
+		"game.start.wait":      {Other: "Вы играете за %[1]s. Ждите здесь, пока %[2]s сделает ход."},
+		"game.start.accepted":  {Other: "%[1]s принял(а) ваше приглашение! Вы играете за %[2]s. Ждите здесь первого хода соперника."},
+		"game.over.title":      {Other: "Игра окончена"},
+		"game.over":            {Other: "Эта партия уже закончилась."},
+		"game.won.title":       {Other: "🎉 ВЫ ПОБЕДИЛИ!!! 🏆"},
+		"game.won":             {Other: "Поздравляем! Вы выиграли партию против %[1]s. %[2]s"},
+		"game.lost.title":      {Other: "❌ Вы проиграли. ❌"},
+		"game.lost":            {Other: "Вы проиграли партию против %[1]s. %[2]s Удачи в следующий раз!"},
+		"game.reason.resigned": {Other: "%[1]s сдались."},
+		"game.reason.pieces":   {Other: "У стороны %[1]s не осталось шашек."},
+		"game.reason.moves":    {Other: "У стороны %[1]s не осталось ходов."},
+		"game.reason.time":     {Other: "У стороны %[1]s закончилось время."},
+		"move.sent.title":      {Other: "Ход сделан!"},
+		"move.sent":            {Other: "Ждите здесь хода соперника."},
+		"move.sent.web":        {Other: "Вы сыграли %[1]s на сайте. Ждите здесь хода соперника."},
+
+		// Invites
+		"invite.title":            {Other: "Приглашение на партию в шашки от %[1]s"},
+		"invite.direct":           {Other: "Нажмите  ✅ , чтобы принять приглашение, или  ❌ , чтобы отклонить."},
+		"invite.general":          {Other: "Нажмите  ✅ , чтобы принять приглашение."},
+		"invite.variant":          {Other: "Вариант: %[1]s"},
+		"invite.ballot":           {Other: "Жеребьёвочный дебют: %[1]s"},
+		"invite.casual":           {Other: "Товарищеская партия с подсказками"},
+		"invite.time":             {Other: "Контроль времени: %[1]s"},
+		"invite.color":            {Other: "Вы будете играть за %[1]s."},
+		"invite.sent.title":       {Other: "Готово"},
+		"invite.sent":             {Other: "Приглашение отправлено %[1]s! Вы будете играть за %[2]s."},
+		"invite.accepted.title":   {Other: "Приглашение принято!"},
+		"invite.accepted":         {Other: "Приглашение от %[1]s принято!"},
+		"invite.declined.title":   {Other: "Приглашение отклонено"},
+		"invite.declined":         {Other: "Приглашение от %[1]s отклонено."},
+		"invite.refused.title":    {Other: "Приглашение отклонено"},
+		"invite.refused":          {Other: "%[1]s отклонил(а) ваше приглашение."},
+		"invite.error.option":     {Other: "Неверный параметр"},
+		"invite.error.variant":    {Other: "Неизвестный вариант"},
+		"invite.error.ballot":     {Other: "Жеребьёвочные дебюты играются только в английские шашки"},
+		"invite.error.options":    {Other: "Неверные параметры"},
+		"invite.error.help":       {Other: "%[1]s. Список параметров: `%[2]s help invites`"},
+		"invite.error.recipient":  {Other: "Неверный получатель"},
+		"invite.error.yourself":   {Other: "Нельзя играть против самого себя!"},
+		"invite.error.bot":        {Other: "Нельзя играть против бота!"},
+		"invite.error.mention":    {Other: "Упомяните игрока в формате @<пользователь>. Для общего приглашения никого не упоминайте."},
+		"invite.error.invalid":    {Other: "Неверное приглашение"},
+		"invite.error.multiple":   {Other: "Нельзя пригласить нескольких игроков!"},
+		"invite.error.ai":         {Other: "Партии против бота отключены на этом сервере."},
+		"invite.error.dm":         {Other: "Нельзя отправлять приглашения из личных сообщений"},
+		"invite.error.channels":   {Other: "Приглашения можно отправлять только в %[1]s"},
+		"invite.error.send":       {Other: "Не удалось отправить приглашение."},
+		"invite.error.ballot.bot": {Other: "Не удалось сыграть жеребьёвочный дебют."},
+
+		// Language command
+		"language.title":       {Other: "🗣️  Язык: %[1]s"},
+		"language.description": {Other: "Измените его командой `%[1]s language <код>` или `%[1]s language auto`, чтобы использовать язык сервера. Доступные языки: %[2]s"},
+		"language.error.title": {Other: "Неверный язык"},
+		"language.error":       {Other: "Доступные языки: %[1]s"},
+		"language.error.save":  {Other: "Не удалось сохранить ваш язык."},
+
+		// Accessible mode
+		"accessible.red":  {Other: "Красные шашки: %[1]s. Красные дамки: %[2]s."},
+		"accessible.blue": {Other: "Синие шашки: %[1]s. Синие дамки: %[2]s."},
+		"accessible.none": {Other: "нет"},
+		"accessible.move": {Other: "%[1]s на %[2]s"},
+		"accessible.capture": {
+			One:   "%[2]s бьёт на %[3]s, взята %[1]d шашка",
+			Few:   "%[2]s бьёт на %[3]s, взято %[1]d шашки",
+			Many:  "%[2]s бьёт на %[3]s, взято %[1]d шашек",
+			Other: "%[2]s бьёт на %[3]s, взято %[1]d шашек",
+		},
+		"accessible.last":        {Other: "Последний ход: %[1]s."},
+		"accessible.play":        {Other: "Напишите `%[1]s play <номер>`, чтобы сделать один из ходов ниже."},
+		"accessible.on.title":    {Other: "Доступный режим включён"},
+		"accessible.on":          {Other: "Доски описываются словами, а ваши возможные ходы пронумерованы: напишите `%[1]s play <номер>` в личных сообщениях с партией, чтобы сделать ход. `%[1]s accessible off` снова показывает доски эмодзи."},
+		"accessible.off.title":   {Other: "Доступный режим выключен"},
+		"accessible.off":         {Other: "Доски снова показываются эмодзи."},
+		"accessible.error.title": {Other: "Неверный параметр"},
+		"accessible.error":       {Other: "Используйте `%[1]s accessible on` или `%[1]s accessible off`."},
+		"accessible.error.save":  {Other: "Не удалось сохранить настройку."},
+		"play.error.title":       {Other: "Нельзя сделать ход"},
+		"play.error.turn":        {Other: "В этом канале нет доски, ожидающей вашего хода."},
+		"play.error.number":      {Other: "Выберите один из пронумерованных ходов, от 1 до %[1]d."},
+		"play.error.game":        {Other: "В эту партию можно играть только реакциями."},
+
+		// Commands
+		"command.missing.title": {Other: "Нет команды"},
+		"command.missing":       {Other: "Список команд: напишите %[1]s help"},
+		"command.invalid.title": {Other: "Неизвестная команда"},
+		"command.invalid":       {Other: "Список разделов справки: напишите %[1]s help"},
+		"command.ping":          {Other: "Понг! Действий в очереди: %[1]d, средняя задержка: %[2]s"},
+
+		// Games against the bot
+		"ai.error.start": {Other: "Не удалось начать партию."},
+		"ai.start":       {Other: "Проверьте личные сообщения, вы играете за %[1]s против бота."},
+		"ai.start.wait":  {Other: "Вы играете за %[1]s. Подождите здесь, пока бот сделает ход."},
+
+		// Web board
+		"web.stale.title":       {Other: "Партия продолжилась"},
+		"web.stale":             {Other: "Эта доска устарела, потому что партию продолжили в браузере. Используйте самую новую доску."},
+		"web.error.title":       {Other: "Веб-доска недоступна"},
+		"web.error":             {Other: "У этого бота нет веб-доски."},
+		"web.error.games.title": {Other: "Нет партий"},
+		"web.error.games":       {Other: "Сейчас вы не играете ни одной партии."},
+		"web.link":              {Other: "Против %[1]s за %[2]s: %[3]s"},
+		"web.title":             {Other: "Ваши партии в браузере"},
+		"web.links":             {Other: "Не делитесь этими ссылками, любой, у кого есть ссылка, может ходить за вас.\n%[1]s"},
+		"web.sent.title":        {Other: "Ссылки отправлены!"},
+		"web.sent":              {Other: "Ссылки на ваши партии отправлены в личные сообщения."},
+
+		// Puzzles
+		"puzzle.description":       {Other: "%[1]s: ход за %[2]s, выиграйте. Рейтинг: %[3]d"},
+		"puzzle.rating.title":      {Other: "Рейтинг задач"},
+		"puzzle.rating":            {Other: "Рейтинг задач %[1]s: %[2]d. Решено: %[3]d, не решено: %[4]d."},
+		"puzzle.rating.change":     {Other: "Ваш рейтинг задач теперь %[1]d (%[2]s)."},
+		"puzzle.error.title":       {Other: "Неверная задача"},
+		"puzzle.error":             {Other: "Задачи №%[1]d нет."},
+		"puzzle.error.setup":       {Other: "Не удалось подготовить задачу."},
+		"puzzle.error.send":        {Other: "Не удалось отправить задачу."},
+		"puzzle.error.defence":     {Other: "Не удалось сыграть защиту."},
+		"puzzle.done.title":        {Other: "Всё решено!"},
+		"puzzle.done":              {Other: "Вы попробовали все задачи. Напишите `%[1]s puzzle <номер>`, чтобы попробовать одну снова."},
+		"puzzle.sent.title":        {Other: "Задача отправлена!"},
+		"puzzle.sent":              {Other: "Задача №%[1]d отправлена в личные сообщения."},
+		"puzzle.correct.title":     {Other: "Верно!"},
+		"puzzle.correct":           {Other: "Бот ответил ходом %[1]s. Продолжайте!"},
+		"puzzle.solved.title":      {Other: "Задача решена!"},
+		"puzzle.solved":            {Other: "Отлично, вы нашли все ходы задачи №%[1]d."},
+		"puzzle.failed.title":      {Other: "Не совсем"},
+		"puzzle.failed":            {Other: "Решение было %[1]s."},
+		"puzzle.next":              {Other: "Напишите `%[1]s puzzle` для следующей."},
+		"puzzle.daily.title":       {Other: "🧩 Задача дня - %[1]s"},
+		"puzzle.daily.solve.title": {Other: "Решите её"},
+		"puzzle.daily.solve":       {Other: "Напишите `%[1]s puzzle daily`, и бот отправит её вам в личные сообщения."},
+
+		// Board themes
+		"theme.title":              {Other: "🎨 Тема доски: %[1]s"},
+		"theme.pieces":             {Other: "%[1]s: шашки %[2]s, дамки %[3]s"},
+		"theme.images.title":       {Other: "Изображения"},
+		"theme.images.on":          {Other: "Вкл."},
+		"theme.images.off":         {Other: "Выкл."},
+		"theme.images.web":         {Other: "Вкл., но у этого бота нет веб-доски, поэтому доски всё равно отправляются эмодзи"},
+		"theme.change.title":       {Other: "Изменить"},
+		"theme.change":             {Other: "`%[1]s theme <название>`, одна из %[2]s\n`%[1]s theme images on` показывает доски изображениями"},
+		"theme.error.title":        {Other: "Неверная тема"},
+		"theme.error":              {Other: "Доступные темы: %[1]s"},
+		"theme.error.images.title": {Other: "Неверный параметр"},
+		"theme.error.images":       {Other: "Используйте `%[1]s theme images on` или `%[1]s theme images off`."},
+		"theme.error.custom.title": {Other: "Нет своих эмодзи"},
+		"theme.error.custom":       {Other: "Используйте это на сервере, который выбрал эмодзи через `%[1]s config emojis`."},
+		"theme.error.save":         {Other: "Не удалось сохранить вашу тему."},
+
+		// Server settings
+		"config.title":                  {Other: "⚙️  Настройки сервера"},
+		"config.description":            {Other: "Измените настройку через `%[1]s config <настройка> <значение>`, менять их могут только участники с правом управлять сервером."},
+		"config.none":                   {Other: "Нет"},
+		"config.on":                     {Other: "Вкл."},
+		"config.off":                    {Other: "Выкл."},
+		"config.prefix.title":           {Other: "Префикс"},
+		"config.prefix":                 {Other: "`%[1]s`\n`%[1]s config prefix <префикс>`"},
+		"config.invites.title":          {Other: "Каналы приглашений"},
+		"config.invites":                {Other: "%[1]s\n`%[2]s config invites <#канал>...` или `all`"},
+		"config.invites.all":            {Other: "Все каналы"},
+		"config.variant.title":          {Other: "Вариант по умолчанию"},
+		"config.variant":                {Other: "%[1]s\n`%[2]s config variant <название>`"},
+		"config.time.title":             {Other: "Контроль времени"},
+		"config.time":                   {Other: "%[1]s\n`%[2]s config time <минуты+добавка>` или `off`"},
+		"config.time.off":               {Other: "Без ограничения времени"},
+		"config.spectators.title":       {Other: "Канал зрителей"},
+		"config.spectators":             {Other: "%[1]s\n`%[2]s config spectators <#канал>` или `off`"},
+		"config.reports.title":          {Other: "Канал разборов"},
+		"config.reports":                {Other: "%[1]s\n`%[2]s config reports <#канал>` или `off`"},
+		"config.ai.title":               {Other: "Партии против бота"},
+		"config.ai":                     {Other: "%[1]s\n`%[2]s config ai on` или `off`"},
+		"config.theme.title":            {Other: "Тема доски"},
+		"config.theme":                  {Other: "%[1]s\n`%[2]s config theme <название>`"},
+		"config.emojis.title":           {Other: "Свои эмодзи"},
+		"config.emojis":                 {Other: "%[1]s\n`%[2]s config emojis <синяя шашка> <красная шашка> <синяя дамка> <красная дамка>`, участники выбирают их через `%[2]s theme custom`"},
+		"config.language.title":         {Other: "Язык"},
+		"config.language":               {Other: "%[1]s\n`%[2]s config language <код>`, один из %[3]s, участники выбирают свой через `%[2]s language`"},
+		"config.error.title":            {Other: "Неверная настройка"},
+		"config.error":                  {Other: "%[1]s. Напишите `%[2]s config`, чтобы увидеть все настройки."},
+		"config.error.value":            {Other: "Нет значения"},
+		"config.error.setting":          {Other: "Неизвестная настройка"},
+		"config.error.prefix":           {Other: "Префикс не может содержать пробелы"},
+		"config.error.channels":         {Other: "Упомяните каналы как #канал"},
+		"config.error.channel":          {Other: "Упомяните канал как #канал"},
+		"config.error.variant":          {Other: "Неизвестный вариант. Доступные варианты: %[1]s"},
+		"config.error.time":             {Other: "Неверный контроль времени, используйте минуты+добавку, например 10+5"},
+		"config.error.ai":               {Other: "Используйте on или off"},
+		"config.error.theme":            {Other: "Доступные темы: %[1]s"},
+		"config.error.emojis":           {Other: "Укажите четыре своих эмодзи"},
+		"config.error.emoji":            {Other: "Можно использовать только свои эмодзи сервера"},
+		"config.error.language":         {Other: "Доступные языки: %[1]s"},
+		"config.error.guild":            {Other: "Настройки можно менять только на сервере"},
+		"config.error.permissions":      {Other: "Не удалось проверить ваши права."},
+		"config.error.permission.title": {Other: "Нет прав"},
+		"config.error.permission":       {Other: "Менять настройки могут только участники с правом управлять сервером."},
+		"config.error.save":             {Other: "Не удалось сохранить настройки."},
+
+		// Selection
+		"select.error.title": {Other: "Неверные реакции"},
+		"select.error":       {Other: "Убедитесь, что вы поставили 1 букву и 1 цифру. Исправьте реакции и попробуйте снова."},
+		"select.error.retry": {Other: "Исправьте реакции и попробуйте снова."},
+
+		// Hints and analysis
+		"hint.title":              {Other: "💡 Подсказка"},
+		"hint.select":             {Other: "Выберите %[1]s %[2]s и передвиньте шашку на 🎯"},
+		"hint.jump":               {Other: "Продолжайте бить на 🎯"},
+		"hint.move":               {Other: "Ход: %[1]s"},
+		"hint.on.title":           {Other: "Подсказки включены"},
+		"hint.off.title":          {Other: "Подсказки выключены"},
+		"hint.changed":            {Other: "Это действует до конца партии."},
+		"hint.off":                {Other: "Напишите `%[1]s hint on`, чтобы снова включить подсказки в этой партии."},
+		"hint.error.title":        {Other: "Нет подсказки"},
+		"hint.error.game":         {Other: "Нет партии"},
+		"hint.error.casual.title": {Other: "Подсказки запрещены"},
+		"hint.error.casual":       {Other: "Подсказки доступны только в товарищеских партиях. Добавьте `casual` к приглашению, чтобы начать такую партию."},
+		"hint.error.turn.title":   {Other: "Не ваш ход"},
+		"hint.error.turn":         {Other: "Дождитесь хода соперника."},
+		"hint.error.option.title": {Other: "Неверный параметр"},
+		"hint.error.option":       {Other: "Используйте `%[1]s hint on` или `%[1]s hint off`, чтобы включить или выключить подсказки."},
+		"hint.error.update":       {Other: "Не удалось обновить партию."},
+		"analysis.title":          {Other: "🔍 Анализ - %[1]s"},
+		"analysis.description": {
+			One:   "Ход: %[2]s, глубина поиска %[1]d полуход.\nFEN: `%[3]s`",
+			Few:   "Ход: %[2]s, глубина поиска %[1]d полухода.\nFEN: `%[3]s`",
+			Many:  "Ход: %[2]s, глубина поиска %[1]d полуходов.\nFEN: `%[3]s`",
+			Other: "Ход: %[2]s, глубина поиска %[1]d полуходов.\nFEN: `%[3]s`",
+		},
+		"analysis.score.win": {
+			One:   "Выигрыш через %[1]d полуход",
+			Few:   "Выигрыш через %[1]d полухода",
+			Many:  "Выигрыш через %[1]d полуходов",
+			Other: "Выигрыш через %[1]d полуходов",
+		},
+		"analysis.score.loss": {
+			One:   "Проигрыш через %[1]d полуход",
+			Few:   "Проигрыш через %[1]d полухода",
+			Many:  "Проигрыш через %[1]d полуходов",
+			Other: "Проигрыш через %[1]d полуходов",
+		},
+		"analysis.error.title":          {Other: "Анализ невозможен"},
+		"analysis.error.variant.title":  {Other: "Неверный вариант"},
+		"analysis.error.variant":        {Other: "Доступные варианты: %[1]s"},
+		"analysis.error.position.title": {Other: "Нет позиции"},
+		"analysis.error.position":       {Other: "Напишите `%[1]s analyze <FEN>`, чтобы проанализировать позицию. Для справки напишите `%[1]s help analysis`"},
+		"analysis.error.fen.title":      {Other: "Неверная позиция"},
+
+		// Game reports
+		"report.analyzing": {Other: "📊 Анализ партии, отчёт скоро появится здесь..."},
+		"report.error":     {Other: "Не удалось проанализировать партию."},
+		"report.title":     {Other: "📊 Отчёт о партии - %[1]s"},
+		"report.summary":   {Other: "%[1]s %[2]s: %[3]s, %[4]s, %[5]s"},
+		"report.blunders": {
+			One:   "%[1]d зевок",
+			Few:   "%[1]d зевка",
+			Many:  "%[1]d зевков",
+			Other: "%[1]d зевков",
+		},
+		"report.mistakes": {
+			One:   "%[1]d ошибка",
+			Few:   "%[1]d ошибки",
+			Many:  "%[1]d ошибок",
+			Other: "%[1]d ошибок",
+		},
+		"report.inaccuracies": {
+			One:   "%[1]d неточность",
+			Few:   "%[1]d неточности",
+			Many:  "%[1]d неточностей",
+			Other: "%[1]d неточностей",
+		},
+		"report.good":             {Other: "Хороший ход"},
+		"report.inaccuracy":       {Other: "Неточность"},
+		"report.mistake":          {Other: "Ошибка"},
+		"report.blunder":          {Other: "Зевок"},
+		"report.judgement":        {Other: "%[1]s, лучше было %[2]s (%[3]s вместо %[4]s)"},
+		"report.evaluation.title": {Other: "Оценка"},
+		"report.evaluation":       {Other: "Выше линии - преимущество у стороны %[1]s."},
+		"report.moments.title":    {Other: "Ключевые моменты"},
+		"report.moments.none":     {Other: "Неточностей, ошибок и зевков не найдено."},
+		"report.moments.more": {
+			One:   "...и ещё %[1]d в PDN",
+			Few:   "...и ещё %[1]d в PDN",
+			Many:  "...и ещё %[1]d в PDN",
+			Other: "...и ещё %[1]d в PDN",
+		},
+
+		// Adjudication
+		"adjudicate.title":         {Other: "Судейство"},
+		"adjudicate.error.title":   {Other: "Судейство невозможно"},
+		"adjudicate.error.capture": {Other: "Завершите взятие, прежде чем судить партию."},
+		"adjudicate.error.variant": {Other: "Для варианта %[1]s нет базы эндшпилей."},
+		"adjudicate.error.pieces": {
+			One:   "В партии осталась %[1]d шашка, база эндшпилей знает только позиции, где шашек не больше %[2]d.",
+			Few:   "В партии осталось %[1]d шашки, база эндшпилей знает только позиции, где шашек не больше %[2]d.",
+			Many:  "В партии осталось %[1]d шашек, база эндшпилей знает только позиции, где шашек не больше %[2]d.",
+			Other: "В партии осталось %[1]d шашек, база эндшпилей знает только позиции, где шашек не больше %[2]d.",
+		},
+		"adjudicate.win": {
+			One:   "%[2]s выигрывают при идеальной игре за %[1]d полуход.",
+			Few:   "%[2]s выигрывают при идеальной игре за %[1]d полухода.",
+			Many:  "%[2]s выигрывают при идеальной игре за %[1]d полуходов.",
+			Other: "%[2]s выигрывают при идеальной игре за %[1]d полуходов.",
+		},
+		"adjudicate.draw": {Other: "Ни один игрок не может форсировать выигрыш, при идеальной игре партия - ничья."},
+
+		// Spectators and stats
+		"spectate.title": {Other: "👀 Партия: %[1]s"},
+		"spectate.turn":  {Other: "Ход: %[1]s"},
+		"spectate.last":  {Other: "Последний ход"},
+		"spectate.won":   {Other: "%[1]s победили. %[2]s"},
+		"stats.title":    {Other: "Статистика %[1]s"},
+		"stats":          {Other: "Сыграно партий: %[1]d\nВыиграно: %[2]d\nПроиграно: %[3]d"},
+
+		// Help
+		"help.invites.title":          {Other: "✉️  Приглашения - Помощь по шашкам"},
+		"help.invites":                {Other: "Приглашения позволяют начать партию с игроком. Приглашения НЕЛЬЗЯ отправлять:\n  • В личных сообщениях\n  • От ботов или ботам\n  • Самому себе\nДоступные команды ниже."},
+		"help.invites.general.title":  {Other: "Общие приглашения"},
+		"help.invites.general":        {Other: "`%[1]s invite`: Отправляет в канал команды приглашение, которое может принять любой"},
+		"help.invites.direct.title":   {Other: "Личные приглашения"},
+		"help.invites.direct":         {Other: "`%[1]s invite @<пользователь>`: Отправляет приглашение прямо упомянутому пользователю."},
+		"help.invites.colors.title":   {Other: "Цвета"},
+		"help.invites.colors":         {Other: "Добавьте `red`, `blue` или `random` к приглашению, чтобы выбрать свой цвет. Красные всегда ходят первыми. Без цвета вы играете за синих."},
+		"help.invites.ballots.title":  {Other: "Жеребьёвка"},
+		"help.invites.ballots":        {Other: "Добавьте `ballot` к приглашению, чтобы начать партию в английские шашки со случайного дебюта из трёх ходов, как на соревнованиях."},
+		"help.invites.casual.title":   {Other: "Товарищеские партии"},
+		"help.invites.casual":         {Other: "Добавьте `casual` к приглашению, чтобы сыграть товарищескую партию. В товарищеских партиях можно просить у бота подсказки."},
+		"help.invites.variants.title": {Other: "Варианты"},
+		"help.invites.variants":       {Other: "Добавьте `variant:<название>` к приглашению, чтобы сыграть в другой вариант. Доступные варианты: %[1]s. Без варианта вы играете в английские шашки. В `giveaway` побеждает тот, кто первым потеряет все шашки или окажется заперт."},
+
+		"help.select.title":         {Other: "⏺  Выбор - Помощь по шашкам"},
+		"help.select":               {Other: "Выбор делается реакциями. Допустимые реакции ставит бот. Выберите шашку, поставив соответствующие эмодзи."},
+		"help.select.example.title": {Other: "Пример"},
+		"help.select.example":       {Other: "Чтобы выбрать шашку на поле F1, поставьте  🇫 , а затем  1️⃣ ."},
+		"help.select.confirm.title": {Other: "Подтверждение"},
+		"help.select.confirm":       {Other: "Подтвердите выбор реакцией  ✅ . Бот проверит выбор и покажет на доске возможные ходы выбранной шашки."},
+
+		"help.move.title":          {Other: "↗️  Ходы - Помощь по шашкам"},
+		"help.move":                {Other: "Ходы делаются реакциями. Допустимые реакции ставит бот. Ходы выбранной шашки показаны на доске. Сделайте ход, поставив соответствующее эмодзи."},
+		"help.move.example.title":  {Other: "Пример"},
+		"help.move.example":        {Other: "Чтобы пойти выбранной шашкой на поле к северо-востоку от неё, поставьте  ↗️"},
+		"help.move.numbered.title": {Other: "Нумерованные ходы"},
+		"help.move.numbered":       {Other: "Когда шашка может пройти больше одного поля в одном направлении, как дамки в международных шашках, ходы нумеруются. Поставьте номер, показанный на нужном поле."},
+		"help.move.cancel.title":   {Other: "Отмена"},
+		"help.move.cancel":         {Other: "Чтобы выбрать другую шашку, поставьте  ❌ . Это вернёт вас к выбору."},
+
+		"help.analysis.title":         {Other: "🔍  Анализ - Помощь по шашкам"},
+		"help.analysis":               {Other: "Бот может изучить позицию и подсказать лучшие ходы."},
+		"help.analysis.hints.title":   {Other: "Подсказки"},
+		"help.analysis.hints":         {Other: "`%[1]s hint`: Показывает лучший ход на доске. Работает только в ваш ход в товарищеских партиях, пишите в личных сообщениях с партией."},
+		"help.analysis.off.title":     {Other: "Отключение подсказок"},
+		"help.analysis.off":           {Other: "`%[1]s hint off`: Отключает подсказки до конца партии, `%[1]s hint on` включает их снова."},
+		"help.analysis.analyze.title": {Other: "Анализ позиции"},
+		"help.analysis.analyze":       {Other: "`%[1]s analyze <FEN>`: Показывает три лучших хода в позиции, записанной в FEN, например `%[1]s analyze W:W21,22,K23:B1,2,3`. Добавьте `variant:<название>`, чтобы проанализировать позицию другого варианта."},
+
+		"help.puzzles.title":        {Other: "🧩  Задачи - Помощь по шашкам"},
+		"help.puzzles":              {Other: "Задачи - это позиции с единственным путём к победе. Бот присылает их в личные сообщения, делайте ходы как в обычной партии, а бот играет за другую сторону."},
+		"help.puzzles.start.title":  {Other: "Начать задачу"},
+		"help.puzzles.start":        {Other: "`%[1]s puzzle`: Присылает задачу, близкую к вашему рейтингу, которую вы ещё не решали."},
+		"help.puzzles.daily.title":  {Other: "Задача дня"},
+		"help.puzzles.daily":        {Other: "`%[1]s puzzle daily`: Присылает задачу дня."},
+		"help.puzzles.choose.title": {Other: "Выбрать задачу"},
+		"help.puzzles.choose":       {Other: "`%[1]s puzzle <номер>`: Присылает задачу с этим номером."},
+		"help.puzzles.rating.title": {Other: "Рейтинг"},
+		"help.puzzles.rating":       {Other: "`%[1]s puzzle rating`: Показывает ваш рейтинг в задачах. Рейтинг меняет только первая попытка в каждой задаче."},
+
+		"help.topics.title":            {Other: "ℹ️  Темы - Помощь по шашкам"},
+		"help.topics":                  {Other: "Выберите тему ниже, чтобы получить помощь"},
+		"help.topics.invites.title":    {Other: "✉️  Приглашения"},
+		"help.topics.invites":          {Other: "`%[1]s help invites`: Как отправлять приглашения"},
+		"help.topics.select.title":     {Other: "⏺  Выбор"},
+		"help.topics.select":           {Other: "`%[1]s help select`: Как выбрать шашку"},
+		"help.topics.move.title":       {Other: "↗️  Ходы"},
+		"help.topics.move":             {Other: "`%[1]s help move`: Как сделать ход"},
+		"help.topics.analysis.title":   {Other: "🔍  Анализ"},
+		"help.topics.analysis":         {Other: "`%[1]s help analysis`: Подсказки и анализ позиций"},
+		"help.topics.puzzles.title":    {Other: "🧩  Задачи"},
+		"help.topics.puzzles":          {Other: "`%[1]s help puzzles`: Как решать задачи"},
+		"help.topics.web.title":        {Other: "🌐  Веб-доска"},
+		"help.topics.web":              {Other: "`%[1]s web`: Присылает ссылки, чтобы играть ваши партии в браузере, ходы оттуда появляются и здесь"},
+		"help.topics.theme.title":      {Other: "🎨  Тема доски"},
+		"help.topics.theme":            {Other: "`%[1]s theme <название>`: Меняет вид ваших досок, `shapes` и `contrast` не зависят от цветов. `%[1]s theme images on` показывает доски картинками"},
+		"help.topics.language.title":   {Other: "🗣️  Язык"},
+		"help.topics.language":         {Other: "`%[1]s language <код>`: Меняет язык, на котором бот с вами говорит. Доступные языки: %[2]s"},
+		"help.topics.accessible.title": {Other: "♿  Доступный режим"},
+		"help.topics.accessible":       {Other: "`%[1]s accessible on`: Описывает доски словами для программ чтения с экрана, ходы нумеруются и делаются командой `%[1]s play <номер>`"},
+		"help.topics.config.title":     {Other: "⚙️  Настройки"},
+		"help.topics.config":           {Other: "`%[1]s config`: Показывает настройки сервера, участники, которые могут управлять сервером, могут менять префикс, каналы приглашений, вариант по умолчанию, контроль времени, канал зрителей, партии против бота, тему доски, свои эмодзи и язык"},
+		"help.topics.stats.title":      {Other: "📊  Статистика"},
+		"help.topics.stats":            {Other: "`%[1]s stats [@<пользователь>]`: Показывает, сколько партий выиграли и проиграли вы или упомянутый пользователь"},
+		"help.topics.adjudicate.title": {Other: "⚖️  Судейство"},
+		"help.topics.adjudicate":       {Other: "`%[1]s adjudicate`: Ищет партию из ваших личных сообщений в базе эндшпилей и говорит, кто выигрывает при идеальной игре"},
+	},
+}
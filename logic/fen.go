@@ -0,0 +1,136 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Returned when a position can't be read, every error of ParseFEN wraps it
+var ErrFEN = errors.New("Invalid FEN")
+
+// Positions are written in FEN as the player to move followed by the squares of each players pieces, for example W:W21,22,K23:B1,2,3
+
+// Reads a position written in FEN
+func ParseFEN(fen string, r Rules) (Game, error) {
+	// Positions copied from PDN files are wrapped in a tag
+	fen = strings.TrimSpace(fen)
+	fen = strings.TrimPrefix(fen, "[FEN")
+	fen = strings.Trim(fen, " \"]")
+	fen = strings.TrimSuffix(fen, ".")
+
+	fields := strings.Split(strings.ToUpper(fen), ":")
+	if len(fields) != 3 {
+		return Game{}, ErrFEN
+	}
+
+	game := Game{Variant: r.Name(), Board: strings.Repeat("0", int(r.Size())*int(Width(r)))}
+	switch fields[0] {
+	case "W":
+		game.Turn = White(r)
+	case "B":
+		game.Turn = 3 - White(r)
+	default:
+		return Game{}, fmt.Errorf("%w, the player to move has to be W or B", ErrFEN)
+	}
+
+	board := []byte(game.Board)
+	for _, field := range fields[1:] {
+		if field == "" {
+			return Game{}, ErrFEN
+		}
+
+		player := White(r)
+		if field[0] == 'B' {
+			player = 3 - White(r)
+		} else if field[0] != 'W' {
+			return Game{}, fmt.Errorf("%w, pieces have to be listed for W and B", ErrFEN)
+		}
+
+		for _, piece := range strings.Split(field[1:], ",") {
+			if piece == "" {
+				continue
+			}
+			numbers, king, err := parseSquares(piece, len(board))
+			if err != nil {
+				return Game{}, err
+			}
+
+			for _, n := range numbers {
+				s, err := SquareAtNumber(n, &game)
+				if err != nil {
+					return Game{}, fmt.Errorf("%w, square %d is not on the board", ErrFEN, n)
+				}
+				if board[s.Index] != '0' {
+					return Game{}, fmt.Errorf("%w, square %d has more than one piece", ErrFEN, n)
+				}
+
+				// Men on the last row would already have been crowned
+				crowned := king || (player == game.Turn && s.Y == 0) || (player != game.Turn && int(s.Y) == int(r.Size())-1)
+				board[s.Index] = '0' + player
+				if crowned {
+					board[s.Index] += 2
+				}
+			}
+		}
+	}
+	game.Board = string(board)
+
+	return game, nil
+}
+
+// Reads a square or a range of squares from a FEN on a board with the given number of squares, returns if they are kings
+func parseSquares(piece string, squares int) ([]int, bool, error) {
+	king := strings.HasPrefix(piece, "K")
+	piece = strings.TrimPrefix(piece, "K")
+
+	bounds := strings.Split(piece, "-")
+	first, err := strconv.Atoi(bounds[0])
+	if err != nil || len(bounds) > 2 {
+		return nil, false, fmt.Errorf("%w, %s is not a square", ErrFEN, piece)
+	}
+	last := first
+	if len(bounds) == 2 {
+		if last, err = strconv.Atoi(bounds[1]); err != nil || last < first {
+			return nil, false, fmt.Errorf("%w, %s is not a range of squares", ErrFEN, piece)
+		}
+	}
+
+	// Checked before the range is expanded so a huge range can't use up the memory
+	if first < 1 || last > squares {
+		return nil, false, fmt.Errorf("%w, %s is not on the board", ErrFEN, piece)
+	}
+
+	var numbers []int
+	for n := first; n <= last; n++ {
+		numbers = append(numbers, n)
+	}
+	return numbers, king, nil
+}
+
+// Writes a position in FEN
+func FormatFEN(game *Game) string {
+	white := White(game.Rules())
+	turn := "W"
+	if game.Turn != white {
+		turn = "B"
+	}
+
+	// List the squares in order of their numbers
+	pieces := make(map[uint8][]string)
+	for n := 1; n <= len(game.Board); n++ {
+		s, err := SquareAtNumber(n, game)
+		if err != nil || s.IsEmpty() || s.IsCaptured() {
+			continue
+		}
+
+		square := strconv.Itoa(n)
+		if s.IsKing() {
+			square = "K" + square
+		}
+		pieces[s.Player()] = append(pieces[s.Player()], square)
+	}
+
+	return turn + ":W" + strings.Join(pieces[white], ",") + ":B" + strings.Join(pieces[3-white], ",")
+}
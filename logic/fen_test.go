@@ -0,0 +1,115 @@
+package logic
+
+import (
+	"errors"
+	"testing"
+)
+
+// Positions written in FEN are read and written back the same way
+func TestFENRoundTrip(t *testing.T) {
+	tests := []struct {
+		variant string
+		fen     string
+	}{
+		{"american", "B:W21,22,23,24,25,26,27,28,29,30,31,32:B1,2,3,4,5,6,7,8,9,10,11,12"},
+		{"american", "W:W18,K26:BK3,14"},
+		{"international", "W:W32,35,K50:B1,K27"},
+		{"russian", "B:WK9:B6,11"},
+		{"italian", "W:W22,K24:B18,20"},
+		{"giveaway", "W:W:B18"},
+	}
+
+	for _, test := range tests {
+		r, err := GetRules(test.variant)
+		if err != nil {
+			t.Fatal(err)
+		}
+		game, err := ParseFEN(test.fen, r)
+		if err != nil {
+			t.Errorf("%s in %s can't be read: %s", test.fen, test.variant, err)
+			continue
+		}
+		if got := FormatFEN(&game); got != test.fen {
+			t.Errorf("%s in %s is written back as %s", test.fen, test.variant, got)
+		}
+	}
+}
+
+// Every position reached in the first moves of each variant is the same after being written and read
+func TestFENPositions(t *testing.T) {
+	for _, r := range Variants {
+		positions := []Game{NewGame(r)}
+		for depth := 0; depth < 3; depth++ {
+			var next []Game
+			for _, game := range positions {
+				for _, seq := range GetSequences(&game) {
+					moved := game
+					ApplySequence(seq, &moved)
+					SwapTurn(&moved)
+					next = append(next, moved)
+				}
+			}
+			positions = next
+		}
+
+		for _, game := range positions {
+			fen := FormatFEN(&game)
+			read, err := ParseFEN(fen, r)
+			if err != nil {
+				t.Fatalf("%s in %s can't be read: %s", fen, r.Name(), err)
+			}
+			if read.Board != game.Board || read.Turn != game.Turn {
+				t.Fatalf("%s in %s is read as a different position", fen, r.Name())
+			}
+		}
+	}
+}
+
+// Ranges, PDN tags and men on the last row are read like the positions they stand for
+func TestParseFEN(t *testing.T) {
+	tests := []struct {
+		fen  string
+		want string
+	}{
+		{"W:W21-23:B1", "W:W21,22,23:B1"},
+		{`[FEN "W:W21:B1"]`, "W:W21:B1"},
+		{"w:w21:b1.", "W:W21:B1"},
+		{"W:W2:B1", "W:WK2:B1"},
+		{"W:W21:B30", "W:W21:BK30"},
+	}
+
+	for _, test := range tests {
+		game, err := ParseFEN(test.fen, American{})
+		if err != nil {
+			t.Errorf("%s can't be read: %s", test.fen, err)
+			continue
+		}
+		if got := FormatFEN(&game); got != test.want {
+			t.Errorf("%s is read as %s, want %s", test.fen, got, test.want)
+		}
+	}
+}
+
+// Positions that can't be read return an error wrapping ErrFEN
+func TestParseFENErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"W:W21",
+		"X:W21:B1",
+		"W:X21:B1",
+		"W:W21::",
+		"W:Wa:B1",
+		"W:W21:B33",
+		"W:W0:B1",
+		"W:W23-21:B1",
+		"W:W1-2-3:B4",
+		"W:W21:B21",
+		"W:W1-99999999:B1",
+	}
+
+	for _, fen := range tests {
+		if _, err := ParseFEN(fen, American{}); !errors.Is(err, ErrFEN) {
+			t.Errorf("%q returned %v, want %v", fen, err, ErrFEN)
+		}
+	}
+}
@@ -4,4 +4,23 @@ type Game struct {
 	Selected uint8  // The index of the selected piece
 	Turn     uint8  // Which players turn it is(1 or 2)
 	Board    string // The board represented as a string
+	Variant  string // The name of the variant being played
+}
+
+// Creates a game with the pieces in their starting positions, player 2 moves first
+func NewGame(r Rules) Game {
+	return Game{
+		Selected: 0,
+		Turn:     2,
+		Board:    StartingBoard(r),
+		Variant:  r.Name(),
+	}
+}
+
+// Gets the rules for the variant being played, defaults to american checkers
+func (g *Game) Rules() Rules {
+	if r, err := GetRules(g.Variant); err == nil {
+		return r
+	}
+	return Variants[0]
 }
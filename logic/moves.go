@@ -2,34 +2,243 @@ package logic
 
 import (
 	"errors"
-	"strconv"
+	"strings"
 )
 
 // Represents a move for a piece
 type Move struct {
-	Possible bool   // If the move is possible
-	S        Square // The Square to move the piece to
-	Jumped   Square // The square of the jumped piece if the move was a jump
+	Possible  bool      // If the move is possible
+	S         Square    // The Square to move the piece to
+	Jumped    Square    // The square of the jumped piece if the move was a jump
+	Direction Direction // The direction the piece moves in
 }
 
-// Moves a piece and updates the board
-func MovePiece(s Square, m Move, board *string) {
-	boardRunes := []rune(*board)
-	if m.S.Y == 0 && s.Piece < 3 {
-		// King the piece if it is in the right row and isn't already kinged
-		boardRunes[m.S.Index] = rune(strconv.FormatUint(uint64(s.Piece+2), 10)[0])
+// Represents every jump made by a piece in a single turn
+type Capture struct {
+	From  Square // The square of the jumping piece
+	Jumps []Move // Each jump in the order they are made
+}
+
+// Represents every step a piece makes in a turn
+type Sequence struct {
+	From  Square // The square of the moving piece
+	Steps []Move // Each step in the order they are made
+}
+
+// Gets the square the piece ends up on
+func (seq Sequence) To() Square {
+	return seq.Steps[len(seq.Steps)-1].S
+}
+
+// Checks if the sequence jumps any pieces
+func (seq Sequence) IsCapture() bool {
+	return seq.Steps[0].IsJump()
+}
+
+// Gets the number of kings jumped in a capture
+func (c Capture) Kings() int {
+	kings := 0
+	for _, jump := range c.Jumps {
+		if jump.Jumped.IsKing() {
+			kings++
+		}
+	}
+
+	return kings
+}
+
+// Checks if a move is a jump
+func (m Move) IsJump() bool {
+	return m.Jumped != Square{}
+}
+
+// Checks if a player is in the middle of a multi jump
+func IsMultiJump(game *Game) bool {
+	return strings.IndexByte(game.Board, '0'+CAPTURED) != -1
+}
+
+// Gets all the captures the player whose turn it is can make while following the rules of the variant
+func GetCaptures(game *Game) []Capture {
+	var captures []Capture
+	board := []byte(game.Board)
+
+	if IsMultiJump(game) {
+		// Only the jumping piece can keep jumping
+		if s, err := SquareAtIndex(game.Selected, game); err == nil {
+			captures = s.getCaptures(board, game)
+		}
 	} else {
-		// Copy piece to new position
-		boardRunes[m.S.Index] = rune(strconv.FormatUint(uint64(s.Piece), 10)[0])
+		for i := range board {
+			if s, err := SquareAtIndex(uint8(i), game); err == nil && s.Player() == game.Turn {
+				captures = append(captures, s.getCaptures(board, game)...)
+			}
+		}
 	}
-	// Remove old piece
-	boardRunes[s.Index] = rune('0')
-	// If the move was a jump remove jumped piece
-	if (m.Jumped != Square{}) {
-		boardRunes[m.Jumped.Index] = rune('0')
+
+	// Only keep the captures that the variant allows to be made
+	var allowed []Capture
+	for _, c := range captures {
+		if len(allowed) == 0 {
+			allowed = append(allowed, c)
+		} else if cmp := game.Rules().CompareCaptures(c, allowed[0]); cmp > 0 {
+			allowed = []Capture{c}
+		} else if cmp == 0 {
+			allowed = append(allowed, c)
+		}
 	}
 
-	*board = string(boardRunes)
+	return allowed
+}
+
+// Gets every capture the piece on the square can make, trying out the jumps on the board
+func (s Square) getCaptures(board []byte, game *Game) []Capture {
+	var captures []Capture
+	r := game.Rules()
+	flying := s.IsKing() && r.FlyingKings()
+
+	for _, dir := range Directions {
+		// Men can only jump north
+		if dir.Y > 0 && !s.IsKing() && !r.MenCaptureBackwards() {
+			continue
+		}
+
+		// Find the piece to jump, flying kings can jump from a distance
+		jumped, ok := indexAtDirection(s.Index, dir, r)
+		for ok && flying && board[jumped] == '0' {
+			jumped, ok = indexAtDirection(jumped, dir, r)
+		}
+		if !ok {
+			continue
+		}
+		jumpedSquare := squareOnBoard(jumped, board, r)
+		if jumpedSquare.IsEmpty() || jumpedSquare.Player() == 0 || jumpedSquare.Player() == s.Player() {
+			continue
+		}
+		// Some variants don't let men jump kings
+		if jumpedSquare.IsKing() && !s.IsKing() && !r.MenCaptureKings() {
+			continue
+		}
+
+		// Try every square the piece can land on
+		for land, ok := indexAtDirection(jumped, dir, r); ok && board[land] == '0'; land, ok = indexAtDirection(land, dir, r) {
+			landSquare := squareOnBoard(land, board, r)
+			jump := Move{Possible: true, S: landSquare, Jumped: jumpedSquare, Direction: dir}
+
+			// Make the jump
+			moved := s
+			moved.X, moved.Y, moved.Index = landSquare.X, landSquare.Y, landSquare.Index
+			if moved.Y == 0 && !moved.IsKing() && r.CrownMidCapture() {
+				moved.Piece += 2
+			}
+			board[s.Index] = '0'
+			board[jumped] = '0' + CAPTURED
+			board[land] = '0' + moved.Piece
+
+			next := moved.getCaptures(board, game)
+
+			// Undo the jump
+			board[land] = '0'
+			board[jumped] = '0' + jumpedSquare.Piece
+			board[s.Index] = '0' + s.Piece
+
+			if len(next) == 0 {
+				captures = append(captures, Capture{From: s, Jumps: []Move{jump}})
+			}
+			for _, c := range next {
+				captures = append(captures, Capture{From: s, Jumps: append([]Move{jump}, c.Jumps...)})
+			}
+
+			if !flying {
+				break
+			}
+		}
+	}
+
+	return captures
+}
+
+// Gets a square from a board that is being worked on
+func squareOnBoard(index uint8, board []byte, r Rules) Square {
+	return Square{
+		X:     index % Width(r),
+		Y:     index / Width(r),
+		Index: index,
+		Piece: board[index] - '0',
+	}
+}
+
+// Moves a piece and updates the board, returns true if the piece has to keep jumping
+func MovePiece(s Square, m Move, game *Game) bool {
+	board := []byte(game.Board)
+	piece := s.Piece
+
+	// Some variants king a man as soon as it jumps into the last row
+	if m.IsJump() && m.S.Y == 0 && !s.IsKing() && game.Rules().CrownMidCapture() {
+		piece += 2
+	}
+
+	// Copy piece to new position and remove the old piece
+	board[m.S.Index] = '0' + piece
+	board[s.Index] = '0'
+	// If the move was a jump mark the jumped piece so it can't be jumped again
+	if m.IsJump() {
+		board[m.Jumped.Index] = '0' + CAPTURED
+	}
+	game.Board = string(board)
+
+	// Keep jumping if the piece can
+	if m.IsJump() {
+		game.Selected = m.S.Index
+		if len(GetCaptures(game)) > 0 {
+			return true
+		}
+	}
+
+	// King the piece if it is in the right row and isn't already kinged
+	if m.S.Y == 0 && piece < 3 {
+		board[m.S.Index] = '0' + piece + 2
+	}
+	// Remove the jumped pieces now that the move is over
+	game.Board = strings.ReplaceAll(string(board), string(rune('0'+CAPTURED)), "0")
+
+	return false
+}
+
+// Gets every sequence the player whose turn it is can make while following the rules of the variant
+func GetSequences(game *Game) []Sequence {
+	var sequences []Sequence
+	captures := GetCaptures(game)
+	for _, c := range captures {
+		sequences = append(sequences, Sequence{From: c.From, Steps: c.Jumps})
+	}
+
+	// Regular moves are only allowed if there is no jump that has to be made
+	if len(captures) > 0 && (IsMultiJump(game) || game.Rules().MandatoryCapture()) {
+		return sequences
+	}
+
+	for i := range game.Board {
+		s, err := SquareAtIndex(uint8(i), game)
+		if err != nil || s.Player() != game.Turn {
+			continue
+		}
+		for _, dir := range Directions {
+			for _, m := range s.MovesAtDirection(dir, game) {
+				sequences = append(sequences, Sequence{From: s, Steps: []Move{m}})
+			}
+		}
+	}
+
+	return sequences
+}
+
+// Makes every step of a sequence, the turn is not swapped
+func ApplySequence(seq Sequence, game *Game) {
+	s := seq.From
+	for _, m := range seq.Steps {
+		MovePiece(s, m, game)
+		s, _ = SquareAtIndex(m.S.Index, game)
+	}
 }
 
 // Swaps the turn for a game
@@ -0,0 +1,128 @@
+package logic
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Errors returned when a move written in standard notation can't be read
+var (
+	ErrSquareNumber = errors.New("Invalid square number")
+	ErrNotation     = errors.New("Invalid move notation")
+	ErrImpossible   = errors.New("Move not possible")
+	ErrAmbiguous    = errors.New("Ambiguous move, write out every square")
+)
+
+// Squares are numbered from the top left of the board as seen by white, the player starting on the highest numbered squares
+
+// Gets the player starting on the highest numbered squares
+func White(r Rules) uint8 {
+	if r.BlackMovesFirst() {
+		return 1
+	}
+	return 2
+}
+
+// Gets the standard number of a square
+func SquareNumber(index uint8, game *Game) int {
+	if game.Turn == White(game.Rules()) {
+		return int(index) + 1
+	}
+	return len(game.Board) - int(index)
+}
+
+// Gets a square from its standard number
+func SquareAtNumber(number int, game *Game) (Square, error) {
+	if number < 1 || number > len(game.Board) {
+		return Square{}, ErrSquareNumber
+	}
+
+	if game.Turn == White(game.Rules()) {
+		return SquareAtIndex(uint8(number-1), game)
+	}
+	return SquareAtIndex(uint8(len(game.Board)-number), game)
+}
+
+// Formats a sequence in standard notation, for example 11-15 or 15x22x31
+func FormatSequence(seq Sequence, game *Game) string {
+	if !seq.IsCapture() {
+		return strconv.Itoa(SquareNumber(seq.From.Index, game)) + "-" + strconv.Itoa(SquareNumber(seq.To().Index, game))
+	}
+
+	squares := []string{strconv.Itoa(SquareNumber(seq.From.Index, game))}
+	for _, m := range seq.Steps {
+		squares = append(squares, strconv.Itoa(SquareNumber(m.S.Index, game)))
+	}
+	return strings.Join(squares, "x")
+}
+
+// Finds the sequence written in standard notation, captures can leave out the squares in between
+func ParseSequence(notation string, game *Game) (Sequence, error) {
+	var numbers []int
+	for _, n := range strings.FieldsFunc(notation, func(r rune) bool { return r == '-' || r == 'x' || r == 'X' }) {
+		number, err := strconv.Atoi(n)
+		if err != nil {
+			return Sequence{}, ErrNotation
+		}
+		numbers = append(numbers, number)
+	}
+	if len(numbers) < 2 {
+		return Sequence{}, ErrNotation
+	}
+
+	var found []Sequence
+	for _, seq := range GetSequences(game) {
+		if SquareNumber(seq.From.Index, game) != numbers[0] || SquareNumber(seq.To().Index, game) != numbers[len(numbers)-1] {
+			continue
+		}
+
+		// Check the squares in between if they were given
+		if len(numbers) > 2 {
+			if len(numbers) != len(seq.Steps)+1 {
+				continue
+			}
+			matches := true
+			for i, m := range seq.Steps {
+				if SquareNumber(m.S.Index, game) != numbers[i+1] {
+					matches = false
+				}
+			}
+			if !matches {
+				continue
+			}
+		}
+		found = append(found, seq)
+	}
+
+	if len(found) == 0 {
+		return Sequence{}, ErrImpossible
+	}
+	// Different paths can jump the same pieces and end on the same square
+	for _, seq := range found[1:] {
+		if !sameCaptures(seq, found[0]) {
+			return Sequence{}, ErrAmbiguous
+		}
+	}
+
+	return found[0], nil
+}
+
+// Checks if two sequences jump the same pieces
+func sameCaptures(a Sequence, b Sequence) bool {
+	if len(a.Steps) != len(b.Steps) {
+		return false
+	}
+
+	jumped := make(map[uint8]bool)
+	for _, m := range a.Steps {
+		jumped[m.Jumped.Index] = true
+	}
+	for _, m := range b.Steps {
+		if !jumped[m.Jumped.Index] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,239 @@
+package logic
+
+import (
+	"errors"
+	"strings"
+)
+
+// Defines how a variant of checkers is played
+type Rules interface {
+	Name() string              // Name used to select the variant
+	Title() string             // Readable name of the variant
+	Size() uint8               // Number of squares along each side of the board
+	PieceRows() uint8          // Number of rows each player fills with pieces at the start
+	MenCaptureBackwards() bool // If men can jump backwards
+	FlyingKings() bool         // If kings can move and jump any distance along a diagonal
+	MandatoryCapture() bool    // If a player has to jump when a jump is available
+	MenCaptureKings() bool     // If men can jump kings
+	CrownMidCapture() bool     // If a man reaching the last row in the middle of a multi jump is kinged and keeps jumping as a king
+	Rotated() bool             // If the board is rotated so the bottom left square is light
+	Inverted() bool            // If the player who runs out of moves wins instead of losing
+	BlackMovesFirst() bool     // If the player starting on the lowest numbered squares moves first
+
+	// Compares which of two captures has to be made, returns a positive number if a has to be made over b,
+	// a negative number if b has to be made over a or 0 if the player can choose
+	CompareCaptures(a Capture, b Capture) int
+}
+
+// American checkers(English draughts)
+type American struct{}
+
+func (American) Name() string              { return "american" }
+func (American) Title() string             { return "Checkers" }
+func (American) Size() uint8               { return 8 }
+func (American) PieceRows() uint8          { return 3 }
+func (American) MenCaptureBackwards() bool { return false }
+func (American) FlyingKings() bool         { return false }
+func (American) MandatoryCapture() bool    { return false }
+func (American) MenCaptureKings() bool     { return true }
+func (American) CrownMidCapture() bool     { return false }
+func (American) Rotated() bool             { return false }
+func (American) Inverted() bool            { return false }
+func (American) BlackMovesFirst() bool     { return true }
+func (American) CompareCaptures(a Capture, b Capture) int {
+	return compareCaptures(a, b)
+}
+
+// International draughts played on a 10x10 board
+type International struct{}
+
+func (International) Name() string              { return "international" }
+func (International) Title() string             { return "International draughts" }
+func (International) Size() uint8               { return 10 }
+func (International) PieceRows() uint8          { return 4 }
+func (International) MenCaptureBackwards() bool { return true }
+func (International) FlyingKings() bool         { return true }
+func (International) MandatoryCapture() bool    { return true }
+func (International) MenCaptureKings() bool     { return true }
+func (International) CrownMidCapture() bool     { return false }
+func (International) Rotated() bool             { return false }
+func (International) Inverted() bool            { return false }
+func (International) BlackMovesFirst() bool     { return false }
+func (International) CompareCaptures(a Capture, b Capture) int {
+	return compareCaptures(a, b, byPieces)
+}
+
+// Russian draughts, jumps are mandatory but the player can choose which one to make
+type Russian struct{}
+
+func (Russian) Name() string              { return "russian" }
+func (Russian) Title() string             { return "Russian draughts" }
+func (Russian) Size() uint8               { return 8 }
+func (Russian) PieceRows() uint8          { return 3 }
+func (Russian) MenCaptureBackwards() bool { return true }
+func (Russian) FlyingKings() bool         { return true }
+func (Russian) MandatoryCapture() bool    { return true }
+func (Russian) MenCaptureKings() bool     { return true }
+func (Russian) CrownMidCapture() bool     { return true }
+func (Russian) Rotated() bool             { return false }
+func (Russian) Inverted() bool            { return false }
+func (Russian) BlackMovesFirst() bool     { return false }
+func (Russian) CompareCaptures(a Capture, b Capture) int {
+	return compareCaptures(a, b)
+}
+
+// Brazilian draughts, international draughts played on an 8x8 board
+type Brazilian struct{}
+
+func (Brazilian) Name() string              { return "brazilian" }
+func (Brazilian) Title() string             { return "Brazilian draughts" }
+func (Brazilian) Size() uint8               { return 8 }
+func (Brazilian) PieceRows() uint8          { return 3 }
+func (Brazilian) MenCaptureBackwards() bool { return true }
+func (Brazilian) FlyingKings() bool         { return true }
+func (Brazilian) MandatoryCapture() bool    { return true }
+func (Brazilian) MenCaptureKings() bool     { return true }
+func (Brazilian) CrownMidCapture() bool     { return false }
+func (Brazilian) Rotated() bool             { return false }
+func (Brazilian) Inverted() bool            { return false }
+func (Brazilian) BlackMovesFirst() bool     { return false }
+func (Brazilian) CompareCaptures(a Capture, b Capture) int {
+	return compareCaptures(a, b, byPieces)
+}
+
+// Italian draughts, men can't jump kings and there are strict rules on which jump has to be made
+type Italian struct{}
+
+func (Italian) Name() string              { return "italian" }
+func (Italian) Title() string             { return "Italian draughts" }
+func (Italian) Size() uint8               { return 8 }
+func (Italian) PieceRows() uint8          { return 3 }
+func (Italian) MenCaptureBackwards() bool { return false }
+func (Italian) FlyingKings() bool         { return false }
+func (Italian) MandatoryCapture() bool    { return true }
+func (Italian) MenCaptureKings() bool     { return false }
+func (Italian) CrownMidCapture() bool     { return false }
+func (Italian) Rotated() bool             { return true }
+func (Italian) Inverted() bool            { return false }
+func (Italian) BlackMovesFirst() bool     { return false }
+func (Italian) CompareCaptures(a Capture, b Capture) int {
+	return compareCaptures(a, b, byPieces, byKing, byKings, byEarliestKing)
+}
+
+// Spanish draughts, flying kings on a rotated board where men only jump forwards
+type Spanish struct{}
+
+func (Spanish) Name() string              { return "spanish" }
+func (Spanish) Title() string             { return "Spanish draughts" }
+func (Spanish) Size() uint8               { return 8 }
+func (Spanish) PieceRows() uint8          { return 3 }
+func (Spanish) MenCaptureBackwards() bool { return false }
+func (Spanish) FlyingKings() bool         { return true }
+func (Spanish) MandatoryCapture() bool    { return true }
+func (Spanish) MenCaptureKings() bool     { return true }
+func (Spanish) CrownMidCapture() bool     { return false }
+func (Spanish) Rotated() bool             { return true }
+func (Spanish) Inverted() bool            { return false }
+func (Spanish) BlackMovesFirst() bool     { return false }
+func (Spanish) CompareCaptures(a Capture, b Capture) int {
+	return compareCaptures(a, b, byPieces, byKings)
+}
+
+// Giveaway checkers, played like american checkers except the goal is to lose all your pieces or get blocked
+type Giveaway struct{}
+
+func (Giveaway) Name() string              { return "giveaway" }
+func (Giveaway) Title() string             { return "Giveaway checkers" }
+func (Giveaway) Size() uint8               { return 8 }
+func (Giveaway) PieceRows() uint8          { return 3 }
+func (Giveaway) MenCaptureBackwards() bool { return false }
+func (Giveaway) FlyingKings() bool         { return false }
+func (Giveaway) MandatoryCapture() bool    { return true }
+func (Giveaway) MenCaptureKings() bool     { return true }
+func (Giveaway) CrownMidCapture() bool     { return false }
+func (Giveaway) Rotated() bool             { return false }
+func (Giveaway) Inverted() bool            { return true }
+func (Giveaway) BlackMovesFirst() bool     { return true }
+func (Giveaway) CompareCaptures(a Capture, b Capture) int {
+	return compareCaptures(a, b)
+}
+
+// Iterable slice to loop through all variants, the first one is the default
+var Variants []Rules = []Rules{American{}, International{}, Russian{}, Brazilian{}, Italian{}, Spanish{}, Giveaway{}}
+
+// Returned when there is no variant with a name
+var ErrVariant = errors.New("Unknown variant")
+
+// Gets the rules for a variant by its name
+func GetRules(name string) (Rules, error) {
+	for _, r := range Variants {
+		if strings.EqualFold(r.Name(), name) {
+			return r, nil
+		}
+	}
+
+	return nil, ErrVariant
+}
+
+// Gets the number of playable squares in each row
+func Width(r Rules) uint8 {
+	return r.Size() / 2
+}
+
+// Gets the number of pieces each player starts with
+func Pieces(r Rules) int {
+	return int(r.PieceRows()) * int(Width(r))
+}
+
+// Gets the board at the start of a game
+func StartingBoard(r Rules) string {
+	squares := int(r.Size()) * int(Width(r))
+	pieces := Pieces(r)
+
+	return strings.Repeat("1", pieces) + strings.Repeat("0", squares-pieces*2) + strings.Repeat("2", pieces)
+}
+
+// Compares two captures using each precedence rule in order until one of them decides
+func compareCaptures(a Capture, b Capture, rules ...func(Capture, Capture) int) int {
+	for _, rule := range rules {
+		if c := rule(a, b); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// The capture that takes the most pieces has to be made
+func byPieces(a Capture, b Capture) int {
+	return len(a.Jumps) - len(b.Jumps)
+}
+
+// Capturing with a king has to be done over capturing with a man
+func byKing(a Capture, b Capture) int {
+	if a.From.IsKing() == b.From.IsKing() {
+		return 0
+	} else if a.From.IsKing() {
+		return 1
+	}
+	return -1
+}
+
+// The capture that takes the most kings has to be made
+func byKings(a Capture, b Capture) int {
+	return a.Kings() - b.Kings()
+}
+
+// The capture that reaches a king first has to be made
+func byEarliestKing(a Capture, b Capture) int {
+	for i := 0; i < len(a.Jumps) && i < len(b.Jumps); i++ {
+		if aKing, bKing := a.Jumps[i].Jumped.IsKing(), b.Jumps[i].Jumped.IsKing(); aKing != bKing {
+			if aKing {
+				return 1
+			}
+			return -1
+		}
+	}
+
+	return 0
+}
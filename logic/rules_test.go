@@ -0,0 +1,94 @@
+package logic
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Counts the positions reached after every possible sequence of moves
+func perft(game Game, depth int) int {
+	if depth == 0 {
+		return 1
+	}
+
+	nodes := 0
+	for _, seq := range GetSequences(&game) {
+		next := game
+		ApplySequence(seq, &next)
+		SwapTurn(&next)
+		nodes += perft(next, depth-1)
+	}
+	return nodes
+}
+
+// The number of positions after each move from the start matches the published counts of each variant
+func TestPerft(t *testing.T) {
+	tests := []struct {
+		variant string
+		nodes   []int
+	}{
+		// Captures aren't mandatory in american checkers, so it has more moves than english draughts
+		{"american", []int{7, 49, 379, 2872, 23582}},
+		{"international", []int{9, 81, 658, 4265, 27117}},
+		{"russian", []int{7, 49, 302, 1469, 7482}},
+		{"brazilian", []int{7, 49, 302, 1469, 7473}},
+		{"italian", []int{7, 49, 302, 1469, 7361}},
+		{"spanish", []int{7, 49, 302, 1469, 7361}},
+		{"giveaway", []int{7, 49, 302, 1469, 7361}},
+	}
+
+	if len(tests) != len(Variants) {
+		t.Errorf("%d variants are tested, want %d", len(tests), len(Variants))
+	}
+	for _, test := range tests {
+		r, err := GetRules(test.variant)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for depth, want := range test.nodes {
+			if got := perft(NewGame(r), depth+1); got != want {
+				t.Errorf("Perft %d of %s is %d, want %d", depth+1, test.variant, got, want)
+			}
+		}
+	}
+}
+
+// Only the captures the variant allows are listed, regular moves are only allowed if captures aren't mandatory
+func TestCapturePrecedence(t *testing.T) {
+	tests := []struct {
+		name    string
+		variant string
+		fen     string
+		want    []string
+	}{
+		{"any capture can be made", "international", "W:W32,35:B27,30", []string{"32x21", "35x24"}},
+		{"most pieces", "international", "W:W32,35:B27,17,30,18", []string{"32x21x12x23"}},
+		{"king over man", "italian", "W:W22,K24:B18,20", []string{"24x15"}},
+		{"most kings", "italian", "W:WK22:B18,K19", []string{"22x15"}},
+		{"most kings with flying kings", "spanish", "W:WK22:B18,K19", []string{"22x15", "22x12", "22x8"}},
+		{"men can't capture kings", "italian", "W:W22:BK18", []string{"22-19"}},
+		{"crowned during the capture", "russian", "W:W9:B6,K11", []string{"9x2x16", "9x2x20"}},
+		{"crowned after the capture", "brazilian", "W:W9:B6,K11", []string{"9x2"}},
+		{"optional capture", "american", "W:W22:B18", []string{"22x15", "22-17"}},
+		{"mandatory capture", "giveaway", "W:W22:B18", []string{"22x15"}},
+	}
+
+	for _, test := range tests {
+		r, err := GetRules(test.variant)
+		if err != nil {
+			t.Fatal(err)
+		}
+		game, err := ParseFEN(test.fen, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, seq := range GetSequences(&game) {
+			got = append(got, FormatSequence(seq, &game))
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: %s in %s has moves %v, want %v", test.name, test.fen, test.variant, got, test.want)
+		}
+	}
+}
@@ -7,5 +7,34 @@ import (
 // Gets the score of a game
 func GetScore(g *Game) (int, int) {
 	// Score is in pieces captured so that's why it's a bit counter intuitive
-	return 12 - (strings.Count(g.Board, "2") + strings.Count(g.Board, "4")), 12 - (strings.Count(g.Board, "1") + strings.Count(g.Board, "3"))
+	pieces := Pieces(g.Rules())
+	return pieces - (strings.Count(g.Board, "2") + strings.Count(g.Board, "4")), pieces - (strings.Count(g.Board, "1") + strings.Count(g.Board, "3"))
+}
+
+// Checks if the player whose turn it is has any moves left
+func HasMoves(g *Game) bool {
+	for i := range g.Board {
+		if s, err := SquareAtIndex(uint8(i), g); err == nil && s.Player() == g.Turn {
+			if _, err := s.GetAvailableMoves(g); err == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Gets the winner of the game once the turn has been swapped, returns 0 if the game isn't over
+func GetWinner(g *Game) uint8 {
+	if HasMoves(g) {
+		return 0
+	}
+
+	// The player who can't move loses unless the variant is inverted
+	if g.Rules().Inverted() {
+		return g.Turn
+	} else if g.Turn == 1 {
+		return 2
+	}
+	return 1
 }
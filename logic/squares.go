@@ -2,10 +2,22 @@ package logic
 
 import (
 	"errors"
-	"math"
 	"strconv"
 )
 
+// Errors returned when a piece can't be selected
+var (
+	ErrCoordinates = errors.New("Invalid coordinates")
+	ErrEmpty       = errors.New("Cannot select blank space")
+	ErrOpponent    = errors.New("Cannot select other players piece")
+	ErrKeepJumping = errors.New("Must keep jumping with the same piece")
+	ErrOtherJump   = errors.New("Another piece has to jump")
+	ErrNowhere     = errors.New("Piece has nowhere to move")
+)
+
+// A piece that has been jumped during a multi jump, it is removed once the move is over
+const CAPTURED uint8 = 5
+
 // Basically acts as an enchanced index for the board
 type Square struct {
 	X     uint8 // X coordinate of the square
@@ -16,41 +28,36 @@ type Square struct {
 
 // A direction used for getting moves and squares at a direction relative to the piece
 type Direction struct {
-	Y      string // North or south
-	X      string // East or west
-	Offset int8   // Offset for the index on an even row
+	Y int8 // -1 for north or 1 for south
+	X int8 // -1 for west or 1 for east
 }
 
 // Gives the directions as an enum
-var NORTHWEST Direction = Direction{Y: "north", X: "west", Offset: -4}
-var NORTHEAST Direction = Direction{Y: "north", X: "east", Offset: -3}
-var SOUTHWEST Direction = Direction{Y: "south", X: "west", Offset: 4}
-var SOUTHEAST Direction = Direction{Y: "south", X: "east", Offset: 5}
+var NORTHWEST Direction = Direction{Y: -1, X: -1}
+var NORTHEAST Direction = Direction{Y: -1, X: 1}
+var SOUTHWEST Direction = Direction{Y: 1, X: -1}
+var SOUTHEAST Direction = Direction{Y: 1, X: 1}
 
 // Iterable slice to loop through all directions
 var Directions []Direction = []Direction{NORTHWEST, NORTHEAST, SOUTHWEST, SOUTHEAST}
 
 // Gets a square from coordinates
 func SquareAtCoords(x uint8, y uint8, game *Game) (Square, error) {
-	index := ((y - 1) * 4) + (x - 1)
-	piece, err := strconv.ParseUint(string((game.Board)[index]), 10, 0)
-
-	if err != nil {
-		return Square{}, errors.New("Error parsing piece")
+	width := Width(game.Rules())
+	if x < 1 || x > width || y < 1 || y > game.Rules().Size() {
+		return Square{}, ErrCoordinates
 	}
 
-	return Square{
-		X:     x - 1,
-		Y:     y - 1,
-		Index: index,
-		Piece: uint8(piece),
-	}, nil
+	return SquareAtIndex(((y-1)*width)+(x-1), game)
 }
 
 // Gets a square at an index
 func SquareAtIndex(index uint8, game *Game) (Square, error) {
-	x := index % 4
-	y := uint8(math.Ceil(float64(index / 4)))
+	if int(index) >= len(game.Board) {
+		return Square{}, errors.New("Out of bounds")
+	}
+
+	width := Width(game.Rules())
 	piece, err := strconv.ParseUint(string(game.Board[index]), 10, 0)
 
 	if err != nil {
@@ -58,8 +65,8 @@ func SquareAtIndex(index uint8, game *Game) (Square, error) {
 	}
 
 	return Square{
-		X:     x,
-		Y:     y,
+		X:     index % width,
+		Y:     index / width,
 		Index: index,
 		Piece: uint8(piece),
 	}, nil
@@ -71,9 +78,16 @@ func (s Square) IsEmpty() bool {
 	return s.Piece == 0
 }
 
+// Checks if the piece on the square has already been jumped
+func (s Square) IsCaptured() bool {
+	return s.Piece == CAPTURED
+}
+
 // Gets the player returns 1 or 2
 func (s Square) Player() uint8 {
-	if s.Piece > 2 { // If the piece is a king
+	if s.IsCaptured() {
+		return 0
+	} else if s.Piece > 2 { // If the piece is a king
 		return s.Piece - 2
 	} else {
 		return s.Piece
@@ -82,79 +96,120 @@ func (s Square) Player() uint8 {
 
 // Checks if the piece is a king
 func (s Square) IsKing() bool {
-	return s.Piece > 2
+	return s.Piece > 2 && !s.IsCaptured()
+}
+
+// Gets the index of the square at a given direction from an index
+func indexAtDirection(index uint8, direction Direction, r Rules) (uint8, bool) {
+	size := int(r.Size())
+	width := int(Width(r))
+
+	// Playable squares are on odd columns in even rows and on even columns in odd rows, the other way around on rotated boards
+	y := int(index) / width
+	column := int(index)%width*2 + 1 - y%2
+	if r.Rotated() {
+		column = int(index)%width*2 + y%2
+	}
+
+	y += int(direction.Y)
+	column += int(direction.X)
+	if y < 0 || y >= size || column < 0 || column >= size {
+		return 0, false
+	}
+
+	return uint8(y*width + column/2), true
 }
 
 // Gets a square at a given direction
 func (s Square) SquareAtDirection(direction Direction, game *Game) (Square, error) {
-	// Make sure isn't out of range
-	if (s.Y == 0 && direction.Y == "north") || (s.Y == 7 && direction.Y == "south") {
+	index, ok := indexAtDirection(s.Index, direction, game.Rules())
+	if !ok {
 		return Square{}, errors.New("Out of bounds")
 	}
-	// If square is even
-	if s.Y%2 == 0 {
-		if s.X != 3 || direction.X == "west" {
-			return SquareAtIndex(uint8(int8(s.Index)+direction.Offset), game)
-		} else {
-			return Square{}, errors.New("Out of bounds")
-		}
-	} else {
-		if s.X != 0 || direction.X == "east" {
-			return SquareAtIndex(uint8(int8(s.Index)+direction.Offset-1), game)
-		} else {
-			return Square{}, errors.New("Out of bounds")
-		}
-	}
+
+	return SquareAtIndex(index, game)
 }
 
-// Gets a move at a given direction
-func (s Square) MoveAtDirection(direction Direction, game *Game, jumpOnly bool) Move {
-	squareAtDir, err := s.SquareAtDirection(direction, game)
-
-	// Makes sure its a valid square and that it can only move south if it's a king
-	if err == nil && (direction.Y == "north" || s.IsKing()) {
-		// Checks for a normal move
-		if squareAtDir.IsEmpty() && !jumpOnly {
-			return Move{Possible: true, S: squareAtDir}
-		} else if !squareAtDir.IsEmpty() && squareAtDir.Player() != game.Turn { // Checks if it is the oppisite player
-			if jump, err := squareAtDir.SquareAtDirection(direction, game); err == nil && jump.IsEmpty() { // Check if jump is possible
-				return Move{Possible: true, S: jump, Jumped: squareAtDir}
-			}
+// Gets the regular(non jump) moves at a given direction
+func (s Square) MovesAtDirection(direction Direction, game *Game) []Move {
+	var moves []Move
+
+	// Men can only move north
+	if direction.Y > 0 && !s.IsKing() {
+		return moves
+	}
+
+	for current := s; ; {
+		next, err := current.SquareAtDirection(direction, game)
+		if err != nil || !next.IsEmpty() {
+			break
 		}
+
+		moves = append(moves, Move{Possible: true, S: next, Direction: direction})
+		if !s.IsKing() || !game.Rules().FlyingKings() {
+			break
+		}
+		current = next
 	}
-	// Returning a blank move will make the "Possible" field false, making it so we can tell which moves are possible when we iterate
-	return Move{}
+
+	return moves
 }
 
-// Gets all moves avaiable in every direction
-func (s Square) GetAvailableMoves(game *Game, jumpOnly bool) ([]Move, error) {
-	if s.X < 0 || s.X > 3 || s.Y < 0 || s.Y > 7 {
-		return []Move{}, errors.New("Invalid coordinates")
+// Gets all moves avaiable for the piece on the square while following the rules of the variant
+func (s Square) GetAvailableMoves(game *Game) ([]Move, error) {
+	if int(s.Index) >= len(game.Board) {
+		return []Move{}, ErrCoordinates
 	}
 
 	// Validate piece
 	if s.IsEmpty() {
-		return []Move{}, errors.New("Cannot select blank space")
+		return []Move{}, ErrEmpty
 	}
 
 	if s.Player() != game.Turn {
-		return []Move{}, errors.New("Cannot select other players piece")
+		return []Move{}, ErrOpponent
 	}
 
-	// Append each directions move to the list
+	// In the middle of a multi jump only the jumping piece can move
+	multiJump := IsMultiJump(game)
+	if multiJump && s.Index != game.Selected {
+		return []Move{}, ErrKeepJumping
+	}
+
+	captures := GetCaptures(game)
 	var avaliableMoves []Move
-	possibleMoves := false
-	for _, dir := range Directions {
-		move := s.MoveAtDirection(dir, game, jumpOnly)
-		if move.Possible {
-			possibleMoves = true
+	for _, c := range captures {
+		if c.From.Index == s.Index {
+			avaliableMoves = appendUnique(avaliableMoves, c.Jumps[0])
 		}
-		avaliableMoves = append(avaliableMoves, move)
 	}
 
-	if !possibleMoves {
-		return []Move{}, errors.New("Piece has nowhere to move")
+	if len(captures) > 0 && (multiJump || game.Rules().MandatoryCapture()) {
+		if len(avaliableMoves) == 0 {
+			return []Move{}, ErrOtherJump
+		}
+		return avaliableMoves, nil
+	}
+
+	// Append each directions moves to the list
+	for _, dir := range Directions {
+		avaliableMoves = append(avaliableMoves, s.MovesAtDirection(dir, game)...)
+	}
+
+	if len(avaliableMoves) == 0 {
+		return []Move{}, ErrNowhere
 	}
 
 	return avaliableMoves, nil
 }
+
+// Appends a move if a move to the same square jumping the same piece isn't already in the list
+func appendUnique(moves []Move, move Move) []Move {
+	for _, m := range moves {
+		if m.S.Index == move.S.Index && m.Jumped.Index == move.Jumped.Index {
+			return moves
+		}
+	}
+
+	return append(moves, move)
+}
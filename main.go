@@ -1,40 +1,122 @@
 package main
 
 import (
-	"log"
+	"errors"
+	"flag"
+	"log/slog"
+	"math/rand"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/jmsheff/discord-checkers/config"
 	"github.com/jmsheff/discord-checkers/discord"
+	"github.com/jmsheff/discord-checkers/endgame"
+	"github.com/jmsheff/discord-checkers/engine"
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/i18n"
+	"github.com/jmsheff/discord-checkers/metrics"
+	"github.com/jmsheff/discord-checkers/openings"
+	"github.com/jmsheff/discord-checkers/puzzles"
+	"github.com/jmsheff/discord-checkers/stats"
+	"github.com/jmsheff/discord-checkers/storage"
+	"github.com/jmsheff/discord-checkers/web"
+	"github.com/jmsheff/discord-checkers/webhooks"
 )
 
 func main() {
-	// Register the bot
-	token := os.Getenv("BOT_TOKEN")
-	if token == "" {
-		panic("Missing token environment variable")
+	// Log JSON lines so every field of a line can be searched, at the level given in the settings
+	level := new(slog.LevelVar)
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
+
+	// Read the settings from the config file, the environment and the flags, stopping on any that are invalid
+	c, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
 	}
+	startupError("Invalid configuration", err)
+	level.Set(c.LogLevel)
+	engine.DEFAULT.Depth = c.Engine.Depth
+	engine.DEFAULT.Time = c.Engine.Time
+
+	// Seed the random number generator used for random colors
+	rand.Seed(time.Now().UnixNano())
+
+	// Make sure every opening can be played before any game uses them, the ballots can come from a file like the ACF deck
+	if c.BallotsPath != "" {
+		startupError("Could not load the ballots", openings.LoadBallots(c.BallotsPath))
+	}
+	startupError("Invalid opening", openings.Validate())
+
+	// Make sure every puzzle can be solved
+	startupError("Invalid puzzle", puzzles.Validate())
+
+	// Make sure every language has every message
+	startupError("Invalid translation", i18n.Validate())
 
-	b, err := discordgo.New("Bot " + token)
-	if err != nil {
-		panic(err.Error())
+	// Open the file the data of the bot is saved in, without one everything is lost when the bot stops
+	store, err := storage.Open(c.StoragePath)
+	startupError("Could not open the storage", err)
+	discord.UseStore(store)
+	service := games.NewService(games.New(store))
+	stats.Watch(store, service.Bus)
+	service.WatchClocks()
+	service.PruneFinished()
+
+	// Load the endgame databases used to adjudicate games if there are any
+	if c.TablebasePath != "" {
+		databases, err := endgame.LoadDir(c.TablebasePath)
+		startupError("Could not load the endgame databases", err)
+		discord.UseTablebases(databases)
 	}
 
+	// Register the bot
+	b, err := discordgo.New("Bot " + c.Token)
+	startupError("Could not create the Discord session", err)
+
 	// Register handlers
 	b.AddHandler(discord.CommandsHandler)
 	b.AddHandler(discord.ReactionsHandler)
+	discord.UseGames(b, service, c.WebURL)
+
+	// Serve metrics and the health of the gateway connection if an address was given, for example localhost:9090
+	if c.Features.Metrics && c.MetricsAddr != "" {
+		watchMetrics(b, service)
+		go func() {
+			slog.Error("Could not serve the metrics", "addr", c.MetricsAddr, "err", http.ListenAndServe(c.MetricsAddr, metrics.Handler()))
+			os.Exit(1)
+		}()
+	}
+
+	// Send game events to other sites if any are listening
+	if c.Features.Webhooks && c.WebhookURLs != "" {
+		hooks := webhooks.New(store, webhooks.ParseEndpoints(c.WebhookURLs, c.WebhookSecret))
+		webhooks.Watch(hooks, service.Bus)
+		discord.UseWebhooks(hooks)
+		hooks.Start()
+	}
+
+	// Serve the web board and API if an address was given, for example :8080
+	if c.Features.Web && c.HTTPAddr != "" {
+		go func() {
+			slog.Error("Could not serve the web board", "addr", c.HTTPAddr, "err", http.ListenAndServe(c.HTTPAddr, web.New(service, c.WebURL)))
+			os.Exit(1)
+		}()
+	}
 
 	// Open a websocket connection to Discord and begin listening.
-	err = b.Open()
-	if err != nil {
-		log.Panic("Could not connect to discord", err)
-		return
+	startupError("Could not connect to discord", b.Open())
+
+	// Post a puzzle every day if a channel was given
+	if c.Features.DailyPuzzles && c.PuzzleChannel != "" {
+		discord.StartDailyPuzzles(b, c.PuzzleChannel, time.Duration(c.PuzzleTime))
 	}
 
 	// Wait here until CTRL-C or other term signal is received.
-	log.Print("Discord bot is now running. Press CTRL-C to exit.")
+	slog.Info("Discord bot is now running. Press CTRL-C to exit.")
 	sc := make(chan os.Signal, 1)
 	signal.Notify(sc, syscall.SIGINT, syscall.SIGTERM, os.Interrupt, os.Kill)
 	<-sc
@@ -42,3 +124,41 @@ func main() {
 	// Cleanly close down the Discord session.
 	b.Close()
 }
+
+// Logs an error that keeps the bot from starting and exits, nothing happens without an error
+func startupError(msg string, err error) {
+	if err == nil {
+		return
+	}
+
+	// Every invalid setting gets its own line
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			slog.Error(msg, "err", e)
+		}
+	} else {
+		slog.Error(msg, "err", err)
+	}
+	os.Exit(1)
+}
+
+// Records the metrics of the games, of the Discord session and of its queues
+func watchMetrics(b *discordgo.Session, service *games.Service) {
+	metrics.Watch(service)
+	b.Client.Transport = metrics.Transport(b.Client.Transport)
+
+	// The gateway is healthy from when it is ready until it disconnects
+	b.AddHandler(func(s *discordgo.Session, e *discordgo.Ready) { metrics.SetConnected(true) })
+	b.AddHandler(func(s *discordgo.Session, e *discordgo.Resumed) { metrics.SetConnected(true) })
+	b.AddHandler(func(s *discordgo.Session, e *discordgo.Disconnect) { metrics.SetConnected(false) })
+
+	metrics.GaugeFunc("checkers_discord_queue_depth", "Discord actions waiting in the queues of the channels.", func() float64 {
+		return float64(discord.QueueMetrics().Depth)
+	})
+	metrics.GaugeFunc("checkers_discord_queue_latency_seconds", "Average time from queueing a Discord action to it being sent.", func() float64 {
+		return discord.QueueMetrics().Latency.Seconds()
+	})
+	metrics.CounterFunc("checkers_discord_queue_retries_total", "Discord requests of the queues retried after a rate limit or a server error.", func() float64 {
+		return float64(discord.QueueMetrics().Retries)
+	})
+}
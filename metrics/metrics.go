@@ -0,0 +1,191 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Metric types, named like in the Prometheus text format
+const (
+	COUNTER   = "counter"
+	GAUGE     = "gauge"
+	HISTOGRAM = "histogram"
+)
+
+// Upper bounds in seconds of the histogram buckets durations are counted in
+var BUCKETS = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// A metric with a value for every combination of its labels
+type Metric struct {
+	Name   string         // Name of the metric, like checkers_games_started_total
+	Help   string         // What the metric measures
+	Type   string         // One of the metric types
+	Labels []string       // Names of the labels the values are split by
+	Read   func() float64 // Reads the value when the metric is scraped instead of keeping it, only for metrics without labels
+
+	values map[string]*value
+}
+
+// The value of a metric for one combination of labels
+type value struct {
+	Labels []string // Values of the labels
+	Value  float64  // The value, the sum of the observations for histograms
+	Counts []uint64 // Number of observations in each bucket of a histogram, not cumulative
+	Count  uint64   // Number of observations of a histogram
+}
+
+// Every metric in the order it was registered
+var registry = struct {
+	sync.Mutex
+	metrics []*Metric
+}{}
+
+// Registers a metric so it is written with the others
+func Register(m *Metric) *Metric {
+	registry.Lock()
+	defer registry.Unlock()
+	m.values = make(map[string]*value)
+	registry.metrics = append(registry.metrics, m)
+	return m
+}
+
+// Creates a counter with the given labels
+func NewCounter(name string, help string, labels ...string) *Metric {
+	return Register(&Metric{Name: name, Help: help, Type: COUNTER, Labels: labels})
+}
+
+// Creates a gauge with the given labels
+func NewGauge(name string, help string, labels ...string) *Metric {
+	return Register(&Metric{Name: name, Help: help, Type: GAUGE, Labels: labels})
+}
+
+// Creates a histogram of durations in seconds with the given labels
+func NewHistogram(name string, help string, labels ...string) *Metric {
+	return Register(&Metric{Name: name, Help: help, Type: HISTOGRAM, Labels: labels})
+}
+
+// Creates a counter that is read when it is scraped
+func CounterFunc(name string, help string, read func() float64) *Metric {
+	return Register(&Metric{Name: name, Help: help, Type: COUNTER, Read: read})
+}
+
+// Creates a gauge that is read when it is scraped
+func GaugeFunc(name string, help string, read func() float64) *Metric {
+	return Register(&Metric{Name: name, Help: help, Type: GAUGE, Read: read})
+}
+
+// Gets the value for the given label values, creating it if needed. The registry has to be locked
+func (m *Metric) value(labels []string) *value {
+	key := strings.Join(labels, "\xff")
+	v, ok := m.values[key]
+	if !ok {
+		v = &value{Labels: append([]string{}, labels...)}
+		if m.Type == HISTOGRAM {
+			v.Counts = make([]uint64, len(BUCKETS))
+		}
+		m.values[key] = v
+	}
+	return v
+}
+
+// Adds to the value of a counter or gauge
+func (m *Metric) Add(delta float64, labels ...string) {
+	registry.Lock()
+	defer registry.Unlock()
+	m.value(labels).Value += delta
+}
+
+// Sets the value of a gauge
+func (m *Metric) Set(v float64, labels ...string) {
+	registry.Lock()
+	defer registry.Unlock()
+	m.value(labels).Value = v
+}
+
+// Counts an observation in a histogram
+func (m *Metric) Observe(observation float64, labels ...string) {
+	registry.Lock()
+	defer registry.Unlock()
+	v := m.value(labels)
+	v.Value += observation
+	v.Count++
+	for i, bound := range BUCKETS {
+		if observation <= bound {
+			v.Counts[i]++
+			break
+		}
+	}
+}
+
+// Writes every metric in the Prometheus text format
+func Write(w io.Writer) {
+	registry.Lock()
+	metrics := append([]*Metric{}, registry.metrics...)
+	registry.Unlock()
+
+	for _, m := range metrics {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.Name, m.Help, m.Name, m.Type)
+
+		// Metrics read on scrape are read without the lock since reading can take time
+		if m.Read != nil {
+			fmt.Fprintf(w, "%s %s\n", m.Name, formatFloat(m.Read()))
+			continue
+		}
+
+		registry.Lock()
+		var values []value
+		for _, v := range m.values {
+			values = append(values, value{Labels: v.Labels, Value: v.Value, Counts: append([]uint64{}, v.Counts...), Count: v.Count})
+		}
+		registry.Unlock()
+
+		// Values are sorted by their labels so scrapes are easy to compare
+		sort.Slice(values, func(i, j int) bool {
+			return strings.Join(values[i].Labels, "\xff") < strings.Join(values[j].Labels, "\xff")
+		})
+		for _, v := range values {
+			labels := formatLabels(m.Labels, v.Labels)
+			if m.Type != HISTOGRAM {
+				fmt.Fprintf(w, "%s%s %s\n", m.Name, labels, formatFloat(v.Value))
+				continue
+			}
+
+			cumulative := uint64(0)
+			for i, bound := range BUCKETS {
+				cumulative += v.Counts[i]
+				fmt.Fprintf(w, "%s_bucket%s %d\n", m.Name, formatLabels(append(m.Labels, "le"), append(v.Labels, formatFloat(bound))), cumulative)
+			}
+			fmt.Fprintf(w, "%s_bucket%s %d\n", m.Name, formatLabels(append(m.Labels, "le"), append(v.Labels, "+Inf")), v.Count)
+			fmt.Fprintf(w, "%s_sum%s %s\n", m.Name, labels, formatFloat(v.Value))
+			fmt.Fprintf(w, "%s_count%s %d\n", m.Name, labels, v.Count)
+		}
+	}
+}
+
+// Escapes label values like the Prometheus text format expects
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// Formats labels as {name="value",...}, empty without labels
+func formatLabels(names []string, values []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	var pairs []string
+	for i, name := range names {
+		v := ""
+		if i < len(values) {
+			v = values[i]
+		}
+		pairs = append(pairs, name+`="`+escaper.Replace(v)+`"`)
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// Formats a value the way Prometheus reads it
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,178 @@
+package metrics
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/engine"
+	"github.com/jmsheff/discord-checkers/games"
+)
+
+// The metrics of the bot
+var (
+	gamesStarted  = NewCounter("checkers_games_started_total", "Games started.", "source")
+	gamesFinished = NewCounter("checkers_games_finished_total", "Games finished, by why they ended.", "reason")
+	moves         = NewCounter("checkers_moves_total", "Moves made, by where they were made.", "source")
+	handlers      = NewHistogram("checkers_handler_duration_seconds", "Time taken by the Discord handlers, by handler.", "handler")
+	apiErrors     = NewCounter("checkers_discord_api_errors_total", "Discord API requests that failed, by endpoint and status.", "method", "endpoint", "status")
+)
+
+// Length of the window moves per minute are counted over
+const MOVES_WINDOW = time.Minute
+
+// Times of the moves made in the last minute
+var recentMoves = struct {
+	sync.Mutex
+	times []time.Time
+}{}
+
+// If the bot is connected to the Discord gateway, 1 when connected
+var connected int32
+
+// Counts the events of every game and how many games are being played
+func Watch(s *games.Service) {
+	s.Bus.Subscribe(func(e games.Event) {
+		switch e := e.(type) {
+		case games.GameStarted:
+			gamesStarted.Add(1, e.Source)
+		case games.GameWon:
+			gamesFinished.Add(1, e.Reason)
+		case games.MoveApplied:
+			moves.Add(1, e.Source)
+			recentMoves.Lock()
+			recentMoves.times = append(recentMoves.times, time.Now())
+			recentMoves.Unlock()
+		}
+	})
+
+	GaugeFunc("checkers_active_games", "Games that are not over.", func() float64 {
+		active := 0
+		for _, g := range s.Store.List() {
+			if !g.Over() {
+				active++
+			}
+		}
+		return float64(active)
+	})
+	GaugeFunc("checkers_moves_per_minute", "Moves made in the last minute.", movesPerMinute)
+	watchEngine()
+}
+
+// Counts the moves made in the last minute and forgets the older ones
+func movesPerMinute() float64 {
+	recentMoves.Lock()
+	defer recentMoves.Unlock()
+	since := time.Now().Add(-MOVES_WINDOW)
+	i := 0
+	for i < len(recentMoves.times) && recentMoves.times[i].Before(since) {
+		i++
+	}
+	recentMoves.times = recentMoves.times[i:]
+	return float64(len(recentMoves.times))
+}
+
+// Reads how many positions the engine searched and how fast
+func watchEngine() {
+	CounterFunc("checkers_engine_nodes_total", "Positions searched by the engine.", func() float64 {
+		nodes, _ := engine.Searched()
+		return float64(nodes)
+	})
+	CounterFunc("checkers_engine_search_seconds_total", "Time spent searching by the engine.", func() float64 {
+		_, elapsed := engine.Searched()
+		return elapsed.Seconds()
+	})
+
+	// The speed is measured over the searches made since the last scrape, it stays the same while the engine is idle
+	var mu sync.Mutex
+	var lastNodes uint64
+	var lastTime time.Duration
+	speed := 0.0
+	GaugeFunc("checkers_engine_nodes_per_second", "Positions searched per second of search since the last scrape.", func() float64 {
+		mu.Lock()
+		defer mu.Unlock()
+		nodes, elapsed := engine.Searched()
+		if elapsed > lastTime {
+			speed = float64(nodes-lastNodes) / (elapsed - lastTime).Seconds()
+		}
+		lastNodes, lastTime = nodes, elapsed
+		return speed
+	})
+}
+
+// Times a Discord handler, the name has to come from a fixed list so the number of values stays small
+func ObserveHandler(name string, elapsed time.Duration) {
+	handlers.Observe(elapsed.Seconds(), name)
+}
+
+// Sets if the bot is connected to the Discord gateway
+func SetConnected(c bool) {
+	v := int32(0)
+	if c {
+		v = 1
+	}
+	atomic.StoreInt32(&connected, v)
+}
+
+// Checks if the bot is connected to the Discord gateway
+func Connected() bool {
+	return atomic.LoadInt32(&connected) == 1
+}
+
+// Matches the parts of Discord API paths that change with every request, IDs and the emojis of reactions
+var (
+	idPattern       = regexp.MustCompile(`/[0-9]+`)
+	reactionPattern = regexp.MustCompile(`/reactions/[^/]+`)
+	versionPattern  = regexp.MustCompile(`^/api/v[0-9]+`)
+)
+
+// Gets the endpoint of a Discord API path, like /channels/:id/messages/:id
+func endpoint(path string) string {
+	path = versionPattern.ReplaceAllString(path, "")
+	path = reactionPattern.ReplaceAllString(path, "/reactions/:emoji")
+	return idPattern.ReplaceAllString(path, "/:id")
+}
+
+// Counts the Discord API requests that fail
+type transport struct {
+	next http.RoundTripper
+}
+
+// Wraps the transport of the HTTP client of a Discord session to count the requests that fail, nil wraps the default transport
+func Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return transport{next: next}
+}
+
+// Makes a request and counts it if it failed
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		apiErrors.Add(1, req.Method, endpoint(req.URL.EscapedPath()), "error")
+	} else if resp.StatusCode >= http.StatusBadRequest {
+		apiErrors.Add(1, req.Method, endpoint(req.URL.EscapedPath()), strconv.Itoa(resp.StatusCode))
+	}
+	return resp, err
+}
+
+// Serves the metrics at /metrics and the state of the gateway connection at /healthz
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		Write(w)
+	})
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		if !Connected() {
+			http.Error(w, "disconnected", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok\n"))
+	})
+	return mux
+}
@@ -0,0 +1,268 @@
+package openings
+
+// Three move ballots for american checkers, a game starts with one drawn at random.
+// These are every three move opening where neither player loses a piece by force within a 10 ply search,
+// each one has to be playable from the starting position which Validate checks.
+// They were generated and are not the 156 ballot deck of the American Checkers Federation, so results can't be compared with ACF play.
+// LoadBallots replaces them with another deck, like the ACF one.
+var Ballots []string = []string{
+	// 9-13
+	"9-13 21-17 5-9",
+	"9-13 21-17 6-9",
+	"9-13 21-17 10-14",
+	"9-13 21-17 10-15",
+	"9-13 21-17 11-15",
+	"9-13 21-17 11-16",
+	"9-13 21-17 12-16",
+	"9-13 22-17 13x22",
+	"9-13 22-18 6-9",
+	"9-13 22-18 10-14",
+	"9-13 22-18 10-15",
+	"9-13 22-18 11-15",
+	"9-13 22-18 11-16",
+	"9-13 22-18 12-16",
+	"9-13 22-18 13-17",
+	"9-13 23-18 5-9",
+	"9-13 23-18 6-9",
+	"9-13 23-18 10-14",
+	"9-13 23-18 10-15",
+	"9-13 23-18 11-15",
+	"9-13 23-18 11-16",
+	"9-13 23-18 12-16",
+	"9-13 23-19 5-9",
+	"9-13 23-19 6-9",
+	"9-13 23-19 10-14",
+	"9-13 23-19 10-15",
+	"9-13 23-19 11-15",
+	"9-13 23-19 11-16",
+	"9-13 24-19 5-9",
+	"9-13 24-19 6-9",
+	"9-13 24-19 10-14",
+	"9-13 24-19 10-15",
+	"9-13 24-19 11-15",
+	"9-13 24-19 11-16",
+	"9-13 24-20 5-9",
+	"9-13 24-20 6-9",
+	"9-13 24-20 10-14",
+	"9-13 24-20 10-15",
+	"9-13 24-20 11-15",
+	"9-13 24-20 11-16",
+	"9-13 24-20 12-16",
+
+	// 9-14
+	"9-14 22-17 5-9",
+	"9-14 22-17 6-9",
+	"9-14 22-17 10-15",
+	"9-14 22-17 11-15",
+	"9-14 22-17 11-16",
+	"9-14 22-18 5-9",
+	"9-14 22-18 6-9",
+	"9-14 22-18 10-15",
+	"9-14 22-18 11-15",
+	"9-14 22-18 11-16",
+	"9-14 22-18 12-16",
+	"9-14 22-18 14-17",
+	"9-14 23-18 14x23",
+	"9-14 23-19 5-9",
+	"9-14 23-19 6-9",
+	"9-14 23-19 10-15",
+	"9-14 23-19 11-15",
+	"9-14 23-19 11-16",
+	"9-14 23-19 14-18",
+	"9-14 24-19 5-9",
+	"9-14 24-19 6-9",
+	"9-14 24-19 10-15",
+	"9-14 24-19 11-15",
+	"9-14 24-19 11-16",
+	"9-14 24-20 5-9",
+	"9-14 24-20 6-9",
+	"9-14 24-20 10-15",
+	"9-14 24-20 11-15",
+	"9-14 24-20 11-16",
+
+	// 10-14
+	"10-14 22-17 6-10",
+	"10-14 22-17 7-10",
+	"10-14 22-17 9-13",
+	"10-14 22-17 11-15",
+	"10-14 22-17 11-16",
+	"10-14 22-17 14-18",
+	"10-14 22-18 6-10",
+	"10-14 22-18 7-10",
+	"10-14 22-18 9-13",
+	"10-14 22-18 11-15",
+	"10-14 22-18 11-16",
+	"10-14 22-18 12-16",
+	"10-14 23-18 14x23",
+	"10-14 23-19 6-10",
+	"10-14 23-19 7-10",
+	"10-14 23-19 9-13",
+	"10-14 23-19 11-15",
+	"10-14 23-19 11-16",
+	"10-14 23-19 14-18",
+	"10-14 24-19 6-10",
+	"10-14 24-19 7-10",
+	"10-14 24-19 9-13",
+	"10-14 24-19 11-15",
+	"10-14 24-19 11-16",
+	"10-14 24-19 14-18",
+	"10-14 24-20 6-10",
+	"10-14 24-20 7-10",
+	"10-14 24-20 9-13",
+	"10-14 24-20 11-15",
+	"10-14 24-20 11-16",
+	"10-14 24-20 14-18",
+
+	// 10-15
+	"10-15 21-17 6-10",
+	"10-15 21-17 7-10",
+	"10-15 21-17 9-13",
+	"10-15 21-17 9-14",
+	"10-15 21-17 11-16",
+	"10-15 21-17 15-18",
+	"10-15 22-17 6-10",
+	"10-15 22-17 7-10",
+	"10-15 22-17 9-13",
+	"10-15 22-17 9-14",
+	"10-15 22-17 11-16",
+	"10-15 22-17 15-18",
+	"10-15 22-17 15-19",
+	"10-15 22-18 15x22",
+	"10-15 23-18 6-10",
+	"10-15 23-18 7-10",
+	"10-15 23-18 9-13",
+	"10-15 23-18 9-14",
+	"10-15 23-18 11-16",
+	"10-15 23-18 12-16",
+	"10-15 23-19 6-10",
+	"10-15 23-19 7-10",
+	"10-15 23-19 9-13",
+	"10-15 23-19 9-14",
+	"10-15 23-19 11-16",
+	"10-15 23-19 15-18",
+	"10-15 24-19 15x24",
+	"10-15 24-20 6-10",
+	"10-15 24-20 7-10",
+	"10-15 24-20 9-13",
+	"10-15 24-20 9-14",
+	"10-15 24-20 11-16",
+	"10-15 24-20 12-16",
+	"10-15 24-20 15-18",
+	"10-15 24-20 15-19",
+
+	// 11-15
+	"11-15 21-17 7-11",
+	"11-15 21-17 8-11",
+	"11-15 21-17 9-13",
+	"11-15 21-17 9-14",
+	"11-15 21-17 10-14",
+	"11-15 21-17 15-19",
+	"11-15 22-17 7-11",
+	"11-15 22-17 8-11",
+	"11-15 22-17 9-13",
+	"11-15 22-17 9-14",
+	"11-15 22-17 10-14",
+	"11-15 22-17 15-18",
+	"11-15 22-17 15-19",
+	"11-15 22-18 15x22",
+	"11-15 23-18 7-11",
+	"11-15 23-18 8-11",
+	"11-15 23-18 9-13",
+	"11-15 23-18 9-14",
+	"11-15 23-18 10-14",
+	"11-15 23-18 12-16",
+	"11-15 23-18 15-19",
+	"11-15 23-19 7-11",
+	"11-15 23-19 8-11",
+	"11-15 23-19 9-13",
+	"11-15 23-19 9-14",
+	"11-15 23-19 10-14",
+	"11-15 24-19 15x24",
+	"11-15 24-20 7-11",
+	"11-15 24-20 8-11",
+	"11-15 24-20 9-13",
+	"11-15 24-20 9-14",
+	"11-15 24-20 10-14",
+	"11-15 24-20 12-16",
+	"11-15 24-20 15-18",
+	"11-15 24-20 15-19",
+
+	// 11-16
+	"11-16 21-17 7-11",
+	"11-16 21-17 8-11",
+	"11-16 21-17 9-13",
+	"11-16 21-17 9-14",
+	"11-16 21-17 10-14",
+	"11-16 21-17 10-15",
+	"11-16 21-17 16-19",
+	"11-16 21-17 16-20",
+	"11-16 22-17 7-11",
+	"11-16 22-17 8-11",
+	"11-16 22-17 9-13",
+	"11-16 22-17 9-14",
+	"11-16 22-17 10-14",
+	"11-16 22-17 10-15",
+	"11-16 22-17 16-19",
+	"11-16 22-17 16-20",
+	"11-16 22-18 7-11",
+	"11-16 22-18 8-11",
+	"11-16 22-18 9-13",
+	"11-16 22-18 9-14",
+	"11-16 22-18 10-14",
+	"11-16 22-18 10-15",
+	"11-16 22-18 16-19",
+	"11-16 22-18 16-20",
+	"11-16 23-18 7-11",
+	"11-16 23-18 8-11",
+	"11-16 23-18 9-13",
+	"11-16 23-18 9-14",
+	"11-16 23-18 10-14",
+	"11-16 23-18 10-15",
+	"11-16 23-18 16-19",
+	"11-16 23-18 16-20",
+	"11-16 23-19 16x23",
+	"11-16 24-19 7-11",
+	"11-16 24-19 8-11",
+	"11-16 24-19 9-13",
+	"11-16 24-19 9-14",
+	"11-16 24-19 10-14",
+	"11-16 24-19 10-15",
+	"11-16 24-19 16-20",
+	"11-16 24-20 7-11",
+	"11-16 24-20 8-11",
+	"11-16 24-20 9-13",
+	"11-16 24-20 9-14",
+	"11-16 24-20 10-14",
+	"11-16 24-20 10-15",
+	"11-16 24-20 16-19",
+
+	// 12-16
+	"12-16 21-17 8-12",
+	"12-16 21-17 9-13",
+	"12-16 21-17 9-14",
+	"12-16 21-17 16-19",
+	"12-16 21-17 16-20",
+	"12-16 22-17 8-12",
+	"12-16 22-17 16-19",
+	"12-16 22-17 16-20",
+	"12-16 22-18 8-12",
+	"12-16 22-18 9-13",
+	"12-16 22-18 9-14",
+	"12-16 22-18 10-14",
+	"12-16 22-18 16-19",
+	"12-16 22-18 16-20",
+	"12-16 23-18 8-12",
+	"12-16 23-18 9-13",
+	"12-16 23-18 9-14",
+	"12-16 23-18 10-15",
+	"12-16 23-18 11-15",
+	"12-16 23-18 16-19",
+	"12-16 23-18 16-20",
+	"12-16 23-19 16x23",
+	"12-16 24-19 8-12",
+	"12-16 24-19 16-20",
+	"12-16 24-20 8-12",
+	"12-16 24-20 9-13",
+	"12-16 24-20 10-15",
+	"12-16 24-20 11-15",
+}
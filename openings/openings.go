@@ -0,0 +1,227 @@
+package openings
+
+import (
+	"bufio"
+	"errors"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// A named opening and the moves that lead to it
+type Opening struct {
+	Name  string // Name of the opening
+	Moves string // Moves in standard notation separated by spaces
+}
+
+// Well known american checkers openings
+var Named []Opening = []Opening{
+	{Name: "Edinburgh", Moves: "9-13"},
+	{Name: "Double Corner", Moves: "9-14"},
+	{Name: "Denny", Moves: "10-14"},
+	{Name: "Kelso", Moves: "10-15"},
+	{Name: "Bristol", Moves: "11-16"},
+	{Name: "Dundee", Moves: "12-16"},
+	{Name: "Switcher", Moves: "11-15 21-17"},
+	{Name: "Single Corner", Moves: "11-15 22-18"},
+	{Name: "Cross", Moves: "11-15 23-18"},
+	{Name: "Second Double Corner", Moves: "11-15 24-19"},
+	{Name: "Bristol Cross", Moves: "11-16 23-18"},
+	{Name: "Dyke", Moves: "11-15 22-17 15-19"},
+	{Name: "Will o' the Wisp", Moves: "11-15 23-19 9-13"},
+	{Name: "Ayrshire Lassie", Moves: "11-15 24-20 8-11"},
+	{Name: "Defiance", Moves: "11-15 23-19 9-14 27-23"},
+	{Name: "Old Fourteenth", Moves: "11-15 23-19 8-11 22-17 4-8"},
+	{Name: "Laird and Lady", Moves: "11-15 23-19 8-11 22-17 9-13"},
+	{Name: "Glasgow", Moves: "11-15 23-19 8-11 22-17 11-16"},
+	{Name: "Alma", Moves: "11-15 23-19 8-11 22-17 3-8"},
+	{Name: "Fife", Moves: "11-15 23-19 9-14 22-17 5-9"},
+	{Name: "Souter", Moves: "11-15 23-19 9-14 22-17 6-9"},
+	{Name: "Whilter", Moves: "11-15 23-19 9-14 22-17 7-11"},
+	{Name: "Maid of the Mill", Moves: "11-15 22-17 8-11 17-13 15-18"},
+}
+
+// Known positions, built the first time they are needed
+var (
+	once   sync.Once
+	names  map[string]string   // Name of the opening for each position
+	book   map[string][]string // Moves that can be played from each position
+	broken error               // Set if one of the openings can't be played
+)
+
+// Gets the key used to look up a position, the board as seen by white so it doesn't matter whose turn it is
+func key(game *logic.Game) string {
+	if game.Turn == logic.White(game.Rules()) {
+		return game.Board
+	}
+
+	board := []byte(game.Board)
+	for i, j := 0, len(board)-1; i < j; i, j = i+1, j-1 {
+		board[i], board[j] = board[j], board[i]
+	}
+	return string(board)
+}
+
+// Checks if openings can be used for a game
+func supported(game *logic.Game) bool {
+	return game.Rules().Name() == logic.American{}.Name()
+}
+
+// Plays moves written in standard notation from the start of an american checkers game
+func Play(moves string) (logic.Game, error) {
+	game := logic.NewGame(logic.American{})
+	for _, notation := range strings.Fields(moves) {
+		seq, err := logic.ParseSequence(notation, &game)
+		if err != nil {
+			return logic.Game{}, errors.New("Could not play " + notation + " in " + moves)
+		}
+		logic.ApplySequence(seq, &game)
+		logic.SwapTurn(&game)
+	}
+
+	return game, nil
+}
+
+// Plays every opening and ballot to find the positions they lead to
+func load() {
+	names = make(map[string]string)
+	book = make(map[string][]string)
+
+	add := func(name string, moves string) {
+		game := logic.NewGame(logic.American{})
+		for _, notation := range strings.Fields(moves) {
+			k := key(&game)
+			if !contains(book[k], notation) {
+				book[k] = append(book[k], notation)
+			}
+
+			seq, err := logic.ParseSequence(notation, &game)
+			if err != nil {
+				broken = errors.New("Could not play " + notation + " in " + moves)
+				return
+			}
+			logic.ApplySequence(seq, &game)
+			logic.SwapTurn(&game)
+		}
+
+		// Named openings take priority over ballots
+		if _, ok := names[key(&game)]; !ok {
+			names[key(&game)] = name
+		}
+	}
+
+	for _, o := range Named {
+		add(o.Name, o.Moves)
+	}
+	for _, b := range Ballots {
+		add("Ballot "+b, b)
+	}
+}
+
+// Checks if a slice contains a string
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Replaces the ballots with the deck in a file, one ballot per line like 11-15 23-19 8-11.
+// Empty lines and lines starting with # are skipped. It has to be called before the openings are used, Validate checks the new deck
+func LoadBallots(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var deck []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		deck = append(deck, strings.Join(strings.Fields(line), " "))
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(deck) == 0 {
+		return errors.New("The deck in " + path + " has no ballots")
+	}
+
+	Ballots = deck
+	return nil
+}
+
+// Checks that every opening and ballot can be played, and that every ballot is three moves and in the deck once
+func Validate() error {
+	once.Do(load)
+	if broken != nil {
+		return broken
+	}
+
+	seen := make(map[string]bool)
+	for _, b := range Ballots {
+		if len(strings.Fields(b)) != 3 {
+			return errors.New("Ballot " + b + " isn't three moves")
+		}
+		if seen[b] {
+			return errors.New("Ballot " + b + " is in the deck twice")
+		}
+		seen[b] = true
+	}
+	return nil
+}
+
+// Gets the name of the opening on the board, returns an empty string if the position isn't a known opening
+func Name(game *logic.Game) string {
+	if !supported(game) {
+		return ""
+	}
+
+	once.Do(load)
+	return names[key(game)]
+}
+
+// Draws a random ballot, returns its number starting from 1
+func RandomBallot() int {
+	return rand.Intn(len(Ballots)) + 1
+}
+
+// Starts an american checkers game with a ballot already played
+func BallotGame(number int) (logic.Game, error) {
+	if number < 1 || number > len(Ballots) {
+		return logic.Game{}, errors.New("Invalid ballot")
+	}
+
+	return Play(Ballots[number-1])
+}
+
+// An opening book built from the named openings and ballots, used by the engine for its first moves
+type Book struct{}
+
+// Gets a random move from the book for the position
+func (Book) Lookup(game *logic.Game) (logic.Sequence, bool) {
+	if !supported(game) || logic.IsMultiJump(game) {
+		return logic.Sequence{}, false
+	}
+
+	once.Do(load)
+	moves := book[key(game)]
+	if len(moves) == 0 {
+		return logic.Sequence{}, false
+	}
+
+	seq, err := logic.ParseSequence(moves[rand.Intn(len(moves))], game)
+	if err != nil {
+		return logic.Sequence{}, false
+	}
+	return seq, true
+}
@@ -0,0 +1,56 @@
+package openings
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// The bundled deck has every generated ballot once and each of them can be played
+func TestBallots(t *testing.T) {
+	if len(Ballots) != 246 {
+		t.Errorf("The deck has %d ballots, want 246", len(Ballots))
+	}
+	seen := make(map[string]bool)
+	for _, b := range Ballots {
+		if len(strings.Fields(b)) != 3 {
+			t.Errorf("Ballot %s isn't three moves", b)
+		}
+		if seen[b] {
+			t.Errorf("Ballot %s is in the deck twice", b)
+		}
+		seen[b] = true
+
+		if _, err := Play(b); err != nil {
+			t.Errorf("Ballot %s can't be played: %s", b, err)
+		}
+	}
+}
+
+// A deck loaded from a file replaces the bundled one, skipping comments and empty lines
+func TestLoadBallots(t *testing.T) {
+	bundled := Ballots
+	defer func() { Ballots = bundled }()
+
+	path := filepath.Join(t.TempDir(), "deck.txt")
+	deck := "# Two ballots\n11-15  23-19 8-11\n\n9-13 22-18 10-15\n"
+	if err := os.WriteFile(path, []byte(deck), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadBallots(path); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"11-15 23-19 8-11", "9-13 22-18 10-15"}
+	if strings.Join(Ballots, ",") != strings.Join(want, ",") {
+		t.Errorf("Loaded %q, want %q", Ballots, want)
+	}
+
+	empty := filepath.Join(t.TempDir(), "empty.txt")
+	if err := os.WriteFile(empty, []byte("# Nothing\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadBallots(empty); err == nil {
+		t.Error("Loaded a deck without ballots")
+	}
+}
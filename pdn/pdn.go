@@ -0,0 +1,132 @@
+package pdn
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Results of a game, white is written first
+const (
+	WHITE_WINS = "2-0"
+	BLACK_WINS = "0-2"
+	DRAW       = "1-1"
+	UNFINISHED = "*"
+)
+
+// The GameType tag of each variant, giveaway checkers doesn't have one
+var gameTypes map[string]int = map[string]int{
+	logic.American{}.Name():      21,
+	logic.International{}.Name(): 20,
+	logic.Italian{}.Name():       22,
+	logic.Spanish{}.Name():       24,
+	logic.Russian{}.Name():       25,
+	logic.Brazilian{}.Name():     26,
+}
+
+// A tag with information about the game
+type Tag struct {
+	Name  string
+	Value string
+}
+
+// A move and its annotations
+type Move struct {
+	Notation string // The move in standard notation
+	Strength string // How good the move was, for example ? or !!
+	Comment  string // A comment written after the move
+}
+
+// A game that can be written in PDN
+type Game struct {
+	Tags   []Tag
+	Moves  []Move
+	Result string
+}
+
+// Creates a game with the standard tags, played from the start of the variant
+func New(r logic.Rules, white string, black string, moves []string, result string) Game {
+	game := Game{
+		Tags: []Tag{
+			{Name: "Event", Value: "?"},
+			{Name: "White", Value: white},
+			{Name: "Black", Value: black},
+			{Name: "Result", Value: result},
+		},
+		Result: result,
+	}
+	if t, ok := gameTypes[r.Name()]; ok {
+		game.Tags = append(game.Tags, Tag{Name: "GameType", Value: strconv.Itoa(t)})
+	}
+
+	for _, m := range moves {
+		game.Moves = append(game.Moves, Move{Notation: m})
+	}
+	return game
+}
+
+// Gets the result of a game from the winner, 0 if the game isn't over
+func Result(winner uint8, r logic.Rules) string {
+	switch winner {
+	case 0:
+		return UNFINISHED
+	case logic.White(r):
+		return WHITE_WINS
+	}
+	return BLACK_WINS
+}
+
+// Sets a tag, replacing it if the game already has it
+func (g *Game) SetTag(name string, value string) {
+	for i, t := range g.Tags {
+		if t.Name == name {
+			g.Tags[i].Value = value
+			return
+		}
+	}
+	g.Tags = append(g.Tags, Tag{Name: name, Value: value})
+}
+
+// Writes the game in PDN, each move number starts with a move of the player who moved first
+func (g *Game) String() string {
+	var b strings.Builder
+	for _, t := range g.Tags {
+		b.WriteString("[" + t.Name + " \"" + strings.ReplaceAll(t.Value, "\"", "'") + "\"]\n")
+	}
+	b.WriteString("\n")
+
+	var tokens []string
+	for i, m := range g.Moves {
+		move := m.Notation + m.Strength
+		if i%2 == 0 {
+			move = strconv.Itoa(i/2+1) + ". " + move
+		}
+		tokens = append(tokens, move)
+		if m.Comment != "" {
+			// Braces end a comment so they can't be in one
+			tokens = append(tokens, "{"+strings.NewReplacer("{", "(", "}", ")").Replace(m.Comment)+"}")
+		}
+	}
+	result := g.Result
+	if result == "" {
+		result = UNFINISHED
+	}
+	tokens = append(tokens, result)
+
+	// Keep the lines short like most PDN files
+	line := ""
+	for _, t := range tokens {
+		if line != "" && len(line)+len(t)+1 > 80 {
+			b.WriteString(line + "\n")
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += t
+	}
+	b.WriteString(line + "\n")
+
+	return b.String()
+}
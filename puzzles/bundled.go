@@ -0,0 +1,47 @@
+package puzzles
+
+// Bundled american checkers puzzles.
+// Shots and multi-jumps were found in engine games and checked with a deeper search, every move of the solver is at least a man and a half better than any other.
+// Endgames were found in the endgame tablebase, every move of the solver is the only one that still wins and the defence holds out the longest.
+// Captures aren't mandatory in american checkers here, so the defence can also choose not to jump
+var Puzzles []Puzzle = []Puzzle{
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:W16,18,19,22,27,28,30,31:B2,4,9,10,11,12,13,14,21", Solution: "14x23x32 16x7 2x11", Rating: 1200},
+	{Theme: "Shot", Variant: "american", FEN: "W:W13,19,21,22,23,24,25,27,28,32:B6,8,10,11,12,14,15,16,18,20", Solution: "22-17 6-9 13x6", Rating: 1500},
+	{Theme: "Endgame", Variant: "american", FEN: "W:W18,32:BK7,28", Solution: "18-15 7-3 15-11 3-8 11x4", Rating: 1400},
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:WK11,19,22,23,25,26,27,30:B9,10,12,13,14,17,21,K31", Solution: "31x24x15x8", Rating: 1150},
+	{Theme: "Shot", Variant: "american", FEN: "W:W13,18,19,22,23,24,25,28,30,31,32:B1,4,6,7,8,9,10,11,12,15,17,21", Solution: "31-26 11-16 18x11x2", Rating: 1500},
+	{Theme: "Endgame", Variant: "american", FEN: "W:W6,32:BK3,28", Solution: "6-2 3-8 2-7 8-12 7-11 12-16 11x20", Rating: 1500},
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:W9,10,17,20,21,24,25,28,30,31:B1,2,4,5,6,11,15,18,27", Solution: "6x13x22x29", Rating: 1150},
+	{Theme: "Shot", Variant: "american", FEN: "W:W13,14,17,19,23,24,25,26,27,28:B5,6,7,9,10,11,12,16,18,20", Solution: "19-15 10x19 24x15x8", Rating: 1500},
+	{Theme: "Endgame", Variant: "american", FEN: "W:WK7,K27:BK8,28", Solution: "27-32 8-12 7-11 12-16 11x20", Rating: 1400},
+	{Theme: "Multi-jump", Variant: "american", FEN: "W:WK3,13,17,18,28,30,32:B1,4,6,9,11,12,19,K27", Solution: "32x23x16x7", Rating: 1150},
+	{Theme: "Shot", Variant: "american", FEN: "B:W17,19,20,21,22,23,24,25,27,28,29:B2,7,8,9,10,11,12,13,14,15,16", Solution: "2-6 23-18 16x23x32", Rating: 1500},
+	{Theme: "Endgame", Variant: "american", FEN: "W:W11,30:BK4,10", Solution: "30-26 10-15 26-23 15-19 23x16 4-8 11x4", Rating: 1500},
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:W16,17,18,21,22,23,24,25,27,28,31,32:B3,5,6,7,8,9,10,11,12,13,14,15", Solution: "12x19x26", Rating: 1100},
+	{Theme: "Shot", Variant: "american", FEN: "B:WK1,K7,19,20,31:B11,12,K14,22,K27", Solution: "27-24 19-15 11x18", Rating: 1500},
+	{Theme: "Endgame", Variant: "american", FEN: "W:WK27,31:B5,28", Solution: "27-32 5-9 31-26 9-14 26-22 14-18 22x15", Rating: 1500},
+	{Theme: "Multi-jump", Variant: "american", FEN: "W:W18,19,20,22,23,27,28,29,30,31:B3,4,6,8,9,11,12,14,15,16,21", Solution: "19x10x1", Rating: 1100},
+	{Theme: "Shot", Variant: "american", FEN: "W:WK2,13,19,21,22,23,24,25,27,32:B6,7,9,10,11,12,14,15,16,18,20", Solution: "32-28 14-17 21x14x5", Rating: 1500},
+	{Theme: "Endgame", Variant: "american", FEN: "W:W12,31:BK4,10", Solution: "31-26 10-15 26-23 15-19 23x16 4-8 12x3", Rating: 1500},
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:W18,19,20,22,23,27,28,29,30,32:B1,3,8,9,10,11,12,14,15,16,21", Solution: "15x24x31", Rating: 1100},
+	{Theme: "Shot", Variant: "american", FEN: "B:W13,18,19,20,22,23,24,25,30:B5,6,7,8,9,10,11,15,16,21", Solution: "8-12 22-17 15x22x29", Rating: 1500},
+	{Theme: "Endgame", Variant: "american", FEN: "W:W7,23:BK4,15", Solution: "7-3 15-19 23x16 4-8 3x12", Rating: 1400},
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:W16,18,20,21,22,25,26,28,29,32:B1,2,3,4,5,7,10,11,14", Solution: "14x23x30", Rating: 1100},
+	{Theme: "Shot", Variant: "american", FEN: "B:W17,18,19,21,22,23,24,25,26,27,28,31:B1,2,3,4,5,10,12,13,14,15,16,20", Solution: "3-8 18x11 8x15 22-18 15x22x29", Rating: 1600},
+	{Theme: "Endgame", Variant: "american", FEN: "W:W12,26:BK4,15", Solution: "26-23 15-19 23x16 4-8 12x3", Rating: 1400},
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:W9,17,18,20,22,23,25,26,28,30,31,32:B1,4,5,6,7,8,10,11,13,15,16,19", Solution: "5x14x21", Rating: 1100},
+	{Theme: "Shot", Variant: "american", FEN: "W:W13,17,18,20,21,22,23,24,28,30,32:B1,6,7,8,9,10,11,12,15,16,19", Solution: "30-26 1-5 32-27 9-14 18x9x2", Rating: 1600},
+	{Theme: "Endgame", Variant: "american", FEN: "W:W15,31:BK3,6", Solution: "15-11 6-10 31-26 10-15 26-23 15-19 23x16 3-8 11x4", Rating: 1600},
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:W16,18,21,23,24,25,27,28,30,31,32:B1,2,3,4,6,7,9,11,12,14,15", Solution: "15x22x29", Rating: 1100},
+	{Theme: "Shot", Variant: "american", FEN: "W:W13,17,18,20,21,22,23,24,27,28,30:B5,6,7,8,9,10,11,12,15,16,19", Solution: "30-26 9-14 18x9x2", Rating: 1500},
+	{Theme: "Endgame", Variant: "american", FEN: "W:W10,29:BK2,9", Solution: "29-25 9-14 25-22 14-18 22x15 2-7 10x3", Rating: 1500},
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:W19,21,22,24,27,29,31:B1,2,4,10,14,15,16,20", Solution: "16x23x32", Rating: 1100},
+	{Theme: "Shot", Variant: "american", FEN: "B:WK16,17,21,22,26,32:B5,9,13,14,20,24,28", Solution: "14-18 22x15 13x22x31", Rating: 1500},
+	{Theme: "Endgame", Variant: "american", FEN: "W:W7,26:BK4,10", Solution: "7-3 10-15 26-23 15-19 23x16 4-8 3x12", Rating: 1500},
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:WK8,15,19,20,21,30,31:B2,4,9,14,22,23,K25,K32", Solution: "4x11x18", Rating: 1100},
+	{Theme: "Shot", Variant: "american", FEN: "B:W13,18,19,20,22,23,24,25,26,29,31,32:B1,4,5,6,8,9,10,11,12,15,16,17", Solution: "17-21 22-17 21x30", Rating: 1500},
+	{Theme: "Endgame", Variant: "american", FEN: "W:W26,K27:B9,28", Solution: "27-32 9-14 26-22 14-18 22x15", Rating: 1400},
+	{Theme: "Multi-jump", Variant: "american", FEN: "W:W5,11,23,28,31,32:B1,4,10,18,20,K25", Solution: "23x14x7", Rating: 1100},
+	{Theme: "Shot", Variant: "american", FEN: "W:W13,14,18,21,22,23,26,27,28:B5,6,7,9,10,11,15,16,19,20", Solution: "21-17 19-24 28x19x12", Rating: 1500},
+	{Theme: "Multi-jump", Variant: "american", FEN: "B:W17,18,19,21,24,25,26,28,29,30,32:B1,2,4,5,6,7,8,10,12,15,16", Solution: "15x22x31", Rating: 1100},
+}
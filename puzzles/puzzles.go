@@ -0,0 +1,124 @@
+package puzzles
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// A position with a single way to win
+type Puzzle struct {
+	Theme    string // What kind of tactic solves the puzzle
+	Variant  string // Name of the variant the puzzle is played in
+	FEN      string // The position at the start of the puzzle
+	Solution string // Moves in standard notation separated by spaces, starting with the solver and alternating with the defender
+	Rating   int    // How hard the puzzle is, on the same scale as player ratings
+}
+
+// The rating players start with
+const START_RATING = 1500
+
+// How much a rating can change after a single puzzle
+const K_FACTOR = 32
+
+// Gets the rules of the variant the puzzle is played in
+func (p Puzzle) Rules() logic.Rules {
+	if r, err := logic.GetRules(p.Variant); err == nil {
+		return r
+	}
+	return logic.Variants[0]
+}
+
+// Gets the position at the start of the puzzle
+func (p Puzzle) Game() (logic.Game, error) {
+	return logic.ParseFEN(p.FEN, p.Rules())
+}
+
+// Gets every move of the solution
+func (p Puzzle) Moves() []string {
+	return strings.Fields(p.Solution)
+}
+
+// Gets a puzzle from its number starting from 1
+func Get(number int) (Puzzle, error) {
+	if number < 1 || number > len(Puzzles) {
+		return Puzzle{}, errors.New("There is no puzzle #" + strconv.Itoa(number))
+	}
+	return Puzzles[number-1], nil
+}
+
+// Checks that every puzzle can be played through its solution
+func Validate() error {
+	for i, p := range Puzzles {
+		game, err := p.Game()
+		if err != nil {
+			return errors.New("Puzzle #" + strconv.Itoa(i+1) + ": " + err.Error())
+		}
+		for _, notation := range p.Moves() {
+			seq, err := logic.ParseSequence(notation, &game)
+			if err != nil {
+				return errors.New("Puzzle #" + strconv.Itoa(i+1) + ": could not play " + notation)
+			}
+			logic.ApplySequence(seq, &game)
+			logic.SwapTurn(&game)
+		}
+	}
+
+	return nil
+}
+
+// Gets the number of the puzzle of the day, every puzzle is used before one repeats
+func Daily(day time.Time) int {
+	days := day.UTC().Unix() / (24 * 60 * 60)
+	return int(days%int64(len(Puzzles))) + 1
+}
+
+// Picks a puzzle close to a rating that hasn't been played yet, returns 0 once every puzzle has been played
+func Pick(rating int, played []int) int {
+	seen := make(map[int]bool)
+	for _, n := range played {
+		seen[n] = true
+	}
+
+	var left []int
+	for i := range Puzzles {
+		if !seen[i+1] {
+			left = append(left, i+1)
+		}
+	}
+	if len(left) == 0 {
+		return 0
+	}
+
+	// Choose randomly between the few closest puzzles so players don't all get the same ones
+	sort.SliceStable(left, func(i, j int) bool {
+		return abs(Puzzles[left[i]-1].Rating-rating) < abs(Puzzles[left[j]-1].Rating-rating)
+	})
+	if len(left) > 5 {
+		left = left[:5]
+	}
+	return left[rand.Intn(len(left))]
+}
+
+// Gets a players new rating after trying a puzzle
+func Update(rating int, p Puzzle, solved bool) int {
+	expected := 1 / (1 + math.Pow(10, float64(p.Rating-rating)/400))
+	score := 0.0
+	if solved {
+		score = 1
+	}
+	return rating + int(math.Round(K_FACTOR*(score-expected)))
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
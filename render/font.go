@@ -0,0 +1,35 @@
+package render
+
+// Glyphs are 5 pixels wide and 7 high, drawn scaled up
+const (
+	GLYPH_WIDTH  = 5
+	GLYPH_HEIGHT = 7
+)
+
+// The pixels of the characters used for coordinates and move markers, arrows use the codes of the directions
+var glyphs = map[string][GLYPH_HEIGHT]string{
+	"0":  {"01110", "10001", "10011", "10101", "11001", "10001", "01110"},
+	"1":  {"00100", "01100", "00100", "00100", "00100", "00100", "01110"},
+	"2":  {"01110", "10001", "00001", "00010", "00100", "01000", "11111"},
+	"3":  {"11111", "00010", "00100", "00010", "00001", "10001", "01110"},
+	"4":  {"00010", "00110", "01010", "10010", "11111", "00010", "00010"},
+	"5":  {"11111", "10000", "11110", "00001", "00001", "10001", "01110"},
+	"6":  {"00110", "01000", "10000", "11110", "10001", "10001", "01110"},
+	"7":  {"11111", "00001", "00010", "00100", "01000", "01000", "01000"},
+	"8":  {"01110", "10001", "10001", "01110", "10001", "10001", "01110"},
+	"9":  {"01110", "10001", "10001", "01111", "00001", "00010", "01100"},
+	"A":  {"01110", "10001", "10001", "11111", "10001", "10001", "10001"},
+	"B":  {"11110", "10001", "10001", "11110", "10001", "10001", "11110"},
+	"C":  {"01110", "10001", "10000", "10000", "10000", "10001", "01110"},
+	"D":  {"11110", "10001", "10001", "10001", "10001", "10001", "11110"},
+	"E":  {"11111", "10000", "10000", "11110", "10000", "10000", "11111"},
+	"F":  {"11111", "10000", "10000", "11110", "10000", "10000", "10000"},
+	"G":  {"01110", "10001", "10000", "10111", "10001", "10001", "01111"},
+	"H":  {"10001", "10001", "10001", "11111", "10001", "10001", "10001"},
+	"I":  {"01110", "00100", "00100", "00100", "00100", "00100", "01110"},
+	"J":  {"00111", "00010", "00010", "00010", "00010", "10010", "01100"},
+	"nw": {"00000", "11110", "11000", "10100", "10010", "00001", "00000"},
+	"ne": {"00000", "01111", "00011", "00101", "01001", "10000", "00000"},
+	"sw": {"00000", "00001", "10010", "10100", "11000", "11110", "00000"},
+	"se": {"00000", "10000", "01001", "00101", "00011", "01111", "00000"},
+}
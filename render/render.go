@@ -0,0 +1,321 @@
+package render
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// Names of the themes
+const (
+	CLASSIC  = "classic"  // Blue and red pieces on a black and white board
+	SHAPES   = "shapes"   // Pieces told apart by their shape instead of their color
+	CONTRAST = "contrast" // White and black pieces on a brown board
+	CUSTOM   = "custom"   // Images chosen by a server, drawn over the classic board
+)
+
+// Size of a square in pixels
+const SQUARE = 48
+
+// Markers drawn on squares that aren't a glyph
+const (
+	MARK_TARGET = "target" // The square a hint moves to
+)
+
+// How a piece is drawn
+type Shape int
+
+const (
+	CIRCLE  Shape = iota // Round pieces like the emojis of the classic theme
+	DIAMOND              // Pieces that can be told apart from circles without seeing color
+)
+
+// The look of one kind of piece
+type Piece struct {
+	Shape Shape
+	Fill  color.RGBA
+	Edge  color.RGBA // Outline, keeps pieces visible on squares of a similar color
+	Crown color.RGBA // The mark drawn in the middle of kings
+}
+
+// Colors and shapes of a board
+type Theme struct {
+	Light  color.RGBA     // Squares that are never played on
+	Dark   color.RGBA     // Squares the pieces stand on
+	Pieces [4]Piece       // Blue men, red men, blue kings and red kings, in the order of the board values
+	Images [4]image.Image // Replace the pieces when not nil, like the custom emojis of a server
+}
+
+// Colors of the emojis used in classic boards, shared by the themes
+var (
+	black  = color.RGBA{0x31, 0x37, 0x3d, 0xff}
+	white  = color.RGBA{0xe6, 0xe7, 0xe8, 0xff}
+	blue   = color.RGBA{0x55, 0xac, 0xee, 0xff}
+	red    = color.RGBA{0xdd, 0x2e, 0x44, 0xff}
+	orange = color.RGBA{0xf4, 0x90, 0x0c, 0xff}
+	gold   = color.RGBA{0xff, 0xcc, 0x4d, 0xff}
+	brown  = color.RGBA{0x8b, 0x5a, 0x2b, 0xff}
+	pure   = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	ink    = color.RGBA{0x00, 0x00, 0x00, 0xff}
+)
+
+// Colors of the markers that are colored squares instead of glyphs
+var markColors = map[string]color.RGBA{
+	"red":    red,
+	"orange": orange,
+	"yellow": gold,
+	"green":  {0x78, 0xb1, 0x59, 0xff},
+	"blue":   blue,
+	"purple": {0xaa, 0x8e, 0xd6, 0xff},
+	"brown":  {0xc1, 0x69, 0x4f, 0xff},
+}
+
+// Every theme by name, custom themes start from the classic one
+var Themes = map[string]Theme{
+	CLASSIC: {
+		Light: white,
+		Dark:  black,
+		Pieces: [4]Piece{
+			{Shape: CIRCLE, Fill: blue, Edge: ink},
+			{Shape: CIRCLE, Fill: red, Edge: ink},
+			{Shape: CIRCLE, Fill: blue, Edge: ink, Crown: gold},
+			{Shape: CIRCLE, Fill: red, Edge: ink, Crown: gold},
+		},
+	},
+	SHAPES: {
+		Light: white,
+		Dark:  black,
+		Pieces: [4]Piece{
+			{Shape: CIRCLE, Fill: pure, Edge: ink},
+			{Shape: DIAMOND, Fill: orange, Edge: ink},
+			{Shape: CIRCLE, Fill: pure, Edge: ink, Crown: ink},
+			{Shape: DIAMOND, Fill: orange, Edge: ink, Crown: ink},
+		},
+	},
+	CONTRAST: {
+		Light: pure,
+		Dark:  brown,
+		Pieces: [4]Piece{
+			{Shape: CIRCLE, Fill: pure, Edge: ink},
+			{Shape: CIRCLE, Fill: ink, Edge: pure},
+			{Shape: CIRCLE, Fill: pure, Edge: ink, Crown: red},
+			{Shape: CIRCLE, Fill: ink, Edge: pure, Crown: red},
+		},
+	},
+}
+
+// Gets a theme by name
+func Get(name string) (Theme, error) {
+	if name == CUSTOM {
+		return Themes[CLASSIC], nil
+	}
+	t, ok := Themes[name]
+	if !ok {
+		return Theme{}, errors.New("Unknown theme")
+	}
+	return t, nil
+}
+
+// Draws a board the way it is shown with emojis, with the rows lettered on the left and the columns numbered below.
+// Marks are drawn on squares by index, they are glyph names, colors of markColors or MARK_TARGET
+func Board(board string, r logic.Rules, t Theme, marks map[uint8]string) (*image.RGBA, error) {
+	width := int(logic.Width(r))
+	size := int(r.Size())
+	if len(board) != width*size {
+		return nil, errors.New("The board doesn't fit the variant")
+	}
+
+	margin := SQUARE / 2
+	img := image.NewRGBA(image.Rect(0, 0, margin+size*SQUARE, size*SQUARE+margin))
+	draw.Draw(img, img.Bounds(), &image.Uniform{white}, image.Point{}, draw.Src)
+
+	for i := 0; i < len(board); i++ {
+		row, column := i/width, i%width
+
+		// Rotated boards start the even rows on the other color, like the emoji boards
+		played := column*2 + 1
+		if (row%2 == 0) == r.Rotated() {
+			played = column * 2
+		}
+		for x := column * 2; x < column*2+2; x++ {
+			c := t.Light
+			if x == played {
+				c = t.Dark
+			}
+			fill(img, square(margin+x*SQUARE, row*SQUARE), c)
+		}
+
+		bounds := square(margin+played*SQUARE, row*SQUARE)
+		switch v := board[i]; {
+		case v >= '1' && v <= '4':
+			drawPiece(img, bounds, t, int(v-'1'))
+		case v == '5': // Jumped pieces that are removed once the multi jump is over
+			drawShape(img, bounds, DIAMOND, SQUARE/4, orange)
+		case v != '0':
+			return nil, errors.New("Invalid square in the board")
+		}
+		if mark, ok := marks[uint8(i)]; ok {
+			drawMark(img, bounds, mark)
+		}
+	}
+
+	// Coordinates like the reactions used to select pieces
+	for row := 0; row < size; row++ {
+		drawText(img, image.Rect(0, row*SQUARE, margin, (row+1)*SQUARE), string(rune('A'+row)), black)
+	}
+	for column := 0; column < width; column++ {
+		bounds := image.Rect(margin+column*2*SQUARE, size*SQUARE, margin+(column+1)*2*SQUARE, size*SQUARE+margin)
+		drawText(img, bounds, string(rune('1'+column)), black)
+	}
+
+	return img, nil
+}
+
+// Writes a board as a PNG
+func PNG(w io.Writer, board string, r logic.Rules, t Theme, marks map[uint8]string) error {
+	img, err := Board(board, r, t, marks)
+	if err != nil {
+		return err
+	}
+	return png.Encode(w, img)
+}
+
+// Gets the bounds of a square from its top left corner
+func square(x int, y int) image.Rectangle {
+	return image.Rect(x, y, x+SQUARE, y+SQUARE)
+}
+
+// Fills a rectangle with a color
+func fill(img *image.RGBA, r image.Rectangle, c color.RGBA) {
+	draw.Draw(img, r, &image.Uniform{c}, image.Point{}, draw.Src)
+}
+
+// Checks if a point at dx, dy from the center is inside a shape of a radius
+func inside(shape Shape, dx int, dy int, radius int) bool {
+	if shape == DIAMOND {
+		return abs(dx)+abs(dy) <= radius
+	}
+	return dx*dx+dy*dy <= radius*radius
+}
+
+// Gets the absolute value of an integer
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Draws a shape in the middle of a square
+func drawShape(img *image.RGBA, bounds image.Rectangle, shape Shape, radius int, c color.RGBA) {
+	center := image.Pt((bounds.Min.X+bounds.Max.X)/2, (bounds.Min.Y+bounds.Max.Y)/2)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if inside(shape, x-center.X, y-center.Y, radius) {
+				img.SetRGBA(x, y, c)
+			}
+		}
+	}
+}
+
+// Draws a piece, kings get a crown in the middle
+func drawPiece(img *image.RGBA, bounds image.Rectangle, t Theme, piece int) {
+	if src := t.Images[piece]; src != nil {
+		drawImage(img, bounds.Inset(SQUARE/12), src)
+		return
+	}
+
+	p := t.Pieces[piece]
+	radius := SQUARE*2/5 - 1
+	drawShape(img, bounds, p.Shape, radius, p.Edge)
+	drawShape(img, bounds, p.Shape, radius-2, p.Fill)
+	if piece >= 2 {
+		drawShape(img, bounds, p.Shape, radius/2, p.Crown)
+	}
+}
+
+// Draws an image scaled to fit a rectangle
+func drawImage(img *image.RGBA, r image.Rectangle, src image.Image) {
+	b := src.Bounds()
+	if b.Empty() {
+		return
+	}
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			sx := b.Min.X + (x-r.Min.X)*b.Dx()/r.Dx()
+			sy := b.Min.Y + (y-r.Min.Y)*b.Dy()/r.Dy()
+			sr, sg, sb, sa := src.At(sx, sy).RGBA()
+			if sa == 0 {
+				continue
+			}
+
+			// Blend over what is already drawn
+			dst := img.RGBAAt(x, y)
+			blend := func(s uint32, d uint8) uint8 {
+				return uint8((s + uint32(d)*(0xffff-sa)/0xffff) >> 8)
+			}
+			img.SetRGBA(x, y, color.RGBA{blend(sr, dst.R), blend(sg, dst.G), blend(sb, dst.B), 0xff})
+		}
+	}
+}
+
+// Draws a move marker on a square
+func drawMark(img *image.RGBA, bounds image.Rectangle, mark string) {
+	if c, ok := markColors[mark]; ok {
+		fill(img, bounds.Inset(SQUARE/4), c)
+		return
+	}
+	if mark == MARK_TARGET {
+		drawShape(img, bounds, CIRCLE, SQUARE/3, red)
+		drawShape(img, bounds, CIRCLE, SQUARE/5, pure)
+		drawShape(img, bounds, CIRCLE, SQUARE/12, red)
+		return
+	}
+
+	fill(img, bounds.Inset(SQUARE/8), gold)
+	drawText(img, bounds, mark, ink)
+}
+
+// Draws a glyph name, or each digit of a number, centered in a rectangle
+func drawText(img *image.RGBA, bounds image.Rectangle, text string, c color.RGBA) {
+	names := []string{text}
+	if _, ok := glyphs[text]; !ok {
+		names = strings.Split(text, "")
+	}
+
+	// The largest scale that fits the rectangle with a pixel of space between glyphs
+	scale := (bounds.Dy() - 4) / GLYPH_HEIGHT
+	if s := (bounds.Dx() - 4) / (len(names)*(GLYPH_WIDTH+1) - 1); s < scale {
+		scale = s
+	}
+	if scale < 1 {
+		scale = 1
+	}
+	if scale > 4 {
+		scale = 4
+	}
+
+	textWidth := (len(names)*(GLYPH_WIDTH+1) - 1) * scale
+	left := (bounds.Min.X + bounds.Max.X - textWidth) / 2
+	top := (bounds.Min.Y + bounds.Max.Y - GLYPH_HEIGHT*scale) / 2
+	for n, name := range names {
+		glyph, ok := glyphs[name]
+		if !ok {
+			continue
+		}
+		for y, line := range glyph {
+			for x, pixel := range line {
+				if pixel == '1' {
+					x0 := left + (n*(GLYPH_WIDTH+1)+x)*scale
+					fill(img, image.Rect(x0, top+y*scale, x0+scale, top+(y+1)*scale), c)
+				}
+			}
+		}
+	}
+}
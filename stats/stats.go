@@ -0,0 +1,62 @@
+package stats
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/storage"
+)
+
+// The bucket records are saved in
+const BUCKET = "stats"
+
+// The games a Discord user finished
+type Record struct {
+	Played int // Number of games finished
+	Won    int // Number of games won
+	Lost   int // Number of games lost, including resigned games
+}
+
+// Keeps two games ending at the same time from overwriting each others changes
+var mu sync.Mutex
+
+// Gets the record of a Discord user
+func Get(s *storage.Store, discordID string) Record {
+	var r Record
+	s.Get(BUCKET, discordID, &r)
+	return r
+}
+
+// Adds a finished game to the records of both players, only games between two Discord users count
+func add(s *storage.Store, gameID string, winnerID string, loserID string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	winner, loser := Get(s, winnerID), Get(s, loserID)
+	winner.Played++
+	winner.Won++
+	loser.Played++
+	loser.Lost++
+	if err := s.Put(BUCKET, winnerID, winner); err != nil {
+		slog.Error("Could not save the record of the winner", "game_id", gameID, "user_id", winnerID, "err", err)
+	}
+	if err := s.Put(BUCKET, loserID, loser); err != nil {
+		slog.Error("Could not save the record of the loser", "game_id", gameID, "user_id", loserID, "err", err)
+	}
+}
+
+// Keeps the records up to date as games end
+func Watch(s *storage.Store, bus *games.Bus) {
+	bus.SubscribeAsync(func(e games.Event) {
+		won, ok := e.(games.GameWon)
+		if !ok {
+			return
+		}
+
+		winner, loser := won.Game.Players[won.Winner], won.Game.Players[3-won.Winner]
+		if winner.DiscordID != "" && loser.DiscordID != "" {
+			add(s, won.Game.ID, winner.DiscordID, loser.DiscordID)
+		}
+	})
+}
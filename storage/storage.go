@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// Keeps the data of the bot in a JSON file.
+// Values are grouped in buckets and saved to the file every time one changes
+type Store struct {
+	path    string
+	mu      sync.Mutex
+	buckets map[string]map[string]json.RawMessage
+}
+
+// Opens the store saved at a path, the file is created the first time something is saved.
+// An empty path keeps everything in memory
+func Open(path string) (*Store, error) {
+	s := &Store{path: path, buckets: make(map[string]map[string]json.RawMessage)}
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &s.buckets); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reads a value into v, returns false if there is no value for the key
+func (s *Store) Get(bucket string, key string, v interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, ok := s.buckets[bucket][key]
+	if !ok {
+		return false
+	}
+	return json.Unmarshal(raw, v) == nil
+}
+
+// Saves a value for a key
+func (s *Store) Put(bucket string, key string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.buckets[bucket] == nil {
+		s.buckets[bucket] = make(map[string]json.RawMessage)
+	}
+	s.buckets[bucket][key] = raw
+	return s.save()
+}
+
+// Removes the values of keys, the file is only written once however many keys there are
+func (s *Store) Delete(bucket string, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		delete(s.buckets[bucket], key)
+	}
+	return s.save()
+}
+
+// Gets every key in a bucket in order
+func (s *Store) Keys(bucket string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var keys []string
+	for k := range s.buckets[bucket] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Writes the store to its file, a temporary file is renamed over the old one so it is never left half written
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.buckets, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if dir := filepath.Dir(s.path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	tmp := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
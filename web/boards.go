@@ -0,0 +1,124 @@
+package web
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif" // Animated custom emojis
+	_ "image/png"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/logic"
+	"github.com/jmsheff/discord-checkers/render"
+)
+
+// Where the images of custom emojis are downloaded from
+const EMOJI_URL = "https://cdn.discordapp.com/emojis/"
+
+// Number of custom emoji images kept in memory
+const EMOJI_CACHE = 256
+
+// Downloads custom emojis with a timeout so a slow download can't hold a request forever
+var emojiClient = &http.Client{Timeout: 5 * time.Second}
+
+// Custom emoji images by ID
+var (
+	emojiMu     sync.Mutex
+	emojiImages = make(map[string]image.Image)
+)
+
+// Gets the image of a custom emoji, downloading it the first time
+func emojiImage(id string) (image.Image, bool) {
+	if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+		return nil, false
+	}
+
+	emojiMu.Lock()
+	img, ok := emojiImages[id]
+	emojiMu.Unlock()
+	if ok {
+		return img, true
+	}
+
+	resp, err := emojiClient.Get(EMOJI_URL + id + ".png")
+	if err != nil {
+		return nil, false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, false
+	}
+	img, _, err = image.Decode(resp.Body)
+	if err != nil {
+		return nil, false
+	}
+
+	emojiMu.Lock()
+	defer emojiMu.Unlock()
+	if len(emojiImages) >= EMOJI_CACHE {
+		emojiImages = make(map[string]image.Image)
+	}
+	emojiImages[id] = img
+	return img, true
+}
+
+// Draws a board as a PNG, everything is in the query so Discord can show boards as images:
+// board is the board as seen by the player to move, variant and theme are names,
+// marks are index:marker pairs separated by commas and emojis are the IDs of the custom pieces of the custom theme
+func (s *Server) boardHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	rules := logic.Variants[0]
+	if v := q.Get("variant"); v != "" {
+		var err error
+		if rules, err = logic.GetRules(v); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	theme := render.CLASSIC
+	if t := q.Get("theme"); t != "" {
+		theme = t
+	}
+	t, err := render.Get(theme)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if theme == render.CUSTOM {
+		for i, id := range strings.Split(q.Get("emojis"), ",") {
+			if i >= len(t.Images) {
+				break
+			}
+			if img, ok := emojiImage(id); ok {
+				t.Images[i] = img
+			}
+		}
+	}
+
+	marks := make(map[uint8]string)
+	if m := q.Get("marks"); m != "" {
+		for _, pair := range strings.Split(m, ",") {
+			values := strings.SplitN(pair, ":", 2)
+			index, err := strconv.ParseUint(values[0], 10, 8)
+			if err != nil || len(values) != 2 {
+				writeError(w, http.StatusBadRequest, "Invalid marks")
+				return
+			}
+			marks[uint8(index)] = values[1]
+		}
+	}
+
+	var b bytes.Buffer
+	if err := render.PNG(&b, q.Get("board"), rules, t, marks); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// The same query always draws the same board
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	w.Write(b.Bytes())
+}
@@ -0,0 +1,71 @@
+package web
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Largest request body the API reads, in bytes
+const MAX_BODY = 16 << 10
+
+// How many games one address can create in CREATE_WINDOW
+const CREATE_LIMIT = 10
+
+// The window games created are counted over
+const CREATE_WINDOW = time.Hour
+
+// Counts the games created by every address in the last window
+type limiter struct {
+	mu      sync.Mutex
+	created map[string][]time.Time // When each address created its recent games, oldest first
+}
+
+// Creates a limiter that hasn't counted anything yet
+func newLimiter() *limiter {
+	return &limiter{created: make(map[string][]time.Time)}
+}
+
+// Gets the address a request came from
+func addressOf(r *http.Request) string {
+	addr, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return addr
+}
+
+// Checks if the address of a request can still create a game, nothing is counted until the game is created
+func (l *limiter) allow(r *http.Request) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.forget(time.Now())
+	return len(l.created[addressOf(r)]) < CREATE_LIMIT
+}
+
+// Counts a game created by the address of a request
+func (l *limiter) count(r *http.Request) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	addr := addressOf(r)
+	l.created[addr] = append(l.created[addr], time.Now())
+}
+
+// Forgets the games created before the window, and the addresses that didn't create any since. The lock has to be held
+func (l *limiter) forget(now time.Time) {
+	since := now.Add(-CREATE_WINDOW)
+	for a, times := range l.created {
+		i := 0
+		for i < len(times) && times[i].Before(since) {
+			i++
+		}
+		if i == len(times) {
+			delete(l.created, a)
+		} else {
+			l.created[a] = times[i:]
+		}
+	}
+}
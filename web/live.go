@@ -0,0 +1,310 @@
+package web
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gorilla/websocket"
+	"github.com/jmsheff/discord-checkers/games"
+)
+
+// How often the clocks are sent while a game is being played
+const CLOCK_INTERVAL = time.Second
+
+// How many chat messages are kept for clients that connect later
+const CHAT_HISTORY = 50
+
+// The longest a chat message can be
+const CHAT_LENGTH = 500
+
+// The longest a WebSocket message from a client can be, in bytes
+const MESSAGE_LENGTH = 4 * CHAT_LENGTH
+
+// How many chat messages a connection can send in CHAT_WINDOW
+const CHAT_LIMIT = 5
+
+// The window chat messages are counted over
+const CHAT_WINDOW = 10 * time.Second
+
+// How many events can wait for a slow client before it is dropped
+const SEND_BUFFER = 32
+
+// An event sent to clients watching a game
+type event struct {
+	Type   string          `json:"type"`             // position, clock or chat
+	Game   *gameView       `json:"game,omitempty"`   // The game after a move, for position events
+	Turn   uint8           `json:"turn,omitempty"`   // The player whose clock is running, for clock events
+	Clocks map[uint8]int64 `json:"clocks,omitempty"` // Milliseconds each player has spent thinking, or has left in timed games, for clock events
+	Timed  bool            `json:"timed,omitempty"`  // If the clocks count down, for clock events
+	Chat   *chatMessage    `json:"chat,omitempty"`   // The message, for chat events
+	Error  string          `json:"error,omitempty"`  // Why a message from the client was refused
+	source *games.Game     // Not sent, each client gets its own view of the game as the player it is, for position events
+}
+
+// A chat message sent by a player or a spectator
+type chatMessage struct {
+	Name   string    `json:"name"`
+	Player uint8     `json:"player"` // The player who sent it, 0 for spectators
+	Text   string    `json:"text"`
+	Time   time.Time `json:"time"`
+}
+
+// A message sent by a client
+type clientMessage struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// A connection to a client watching a game
+type client struct {
+	game   string
+	token  string
+	player uint8
+	name   string
+	send   chan event
+	said   []time.Time // When the client sent its recent chat messages, oldest first
+}
+
+// Sends events to every client watching a game
+type hub struct {
+	games   *games.Store
+	public  *url.URL // The public URL of the web board, nil if it isn't known
+	mu      sync.Mutex
+	clients map[string]map[*client]bool // Clients by the ID of the game they watch
+	chat    map[string][]chatMessage    // Recent chat messages of each game
+}
+
+// Creates a hub that sends the position of a game to its clients every time a turn ends
+func newHub(g *games.Service, public *url.URL) *hub {
+	h := &hub{games: g.Store, public: public, clients: make(map[string]map[*client]bool), chat: make(map[string][]chatMessage)}
+	g.Bus.Subscribe(func(e games.Event) {
+		switch e := e.(type) {
+		case games.TurnChanged:
+			h.position(e.Game)
+		case games.GameWon:
+			h.position(e.Game)
+		}
+	})
+	go h.tick()
+	return h
+}
+
+// Sends the position of a game to its clients
+func (h *hub) position(g games.Game) {
+	if g.ID == "" {
+		return
+	}
+	h.broadcast(g.ID, event{Type: "position", source: &g})
+}
+
+// Sends an event to every client of a game, clients that can't keep up are dropped
+func (h *hub) broadcast(id string, e event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for c := range h.clients[id] {
+		personal := e
+		if e.source != nil {
+			v := viewOf(*e.source, c.token)
+			personal.Game = &v
+		}
+
+		select {
+		case c.send <- personal:
+		default:
+			h.remove(c)
+		}
+	}
+}
+
+// Stops sending events to a client, the lock has to be held
+func (h *hub) remove(c *client) {
+	if h.clients[c.game][c] {
+		delete(h.clients[c.game], c)
+		close(c.send)
+	}
+	if len(h.clients[c.game]) == 0 {
+		delete(h.clients, c.game)
+	}
+}
+
+// Sends the clocks of every game being watched until the program stops
+func (h *hub) tick() {
+	for now := range time.Tick(CLOCK_INTERVAL) {
+		h.mu.Lock()
+		var ids []string
+		for id := range h.clients {
+			ids = append(ids, id)
+		}
+		h.mu.Unlock()
+
+		for _, id := range ids {
+			if g, ok := h.games.Get(id); ok && !g.Over() {
+				h.broadcast(id, clockEvent(g, now))
+			}
+		}
+	}
+}
+
+// Creates an event with the clocks of a game
+func clockEvent(g games.Game, now time.Time) event {
+	clocks := map[uint8]int64{}
+	for number := range g.Players {
+		if g.TimeControl.Timed() {
+			clocks[number] = g.Remaining(number, now).Milliseconds()
+		} else {
+			clocks[number] = g.Clock(number, now).Milliseconds()
+		}
+	}
+	return event{Type: "clock", Turn: g.Turn, Clocks: clocks, Timed: g.TimeControl.Timed()}
+}
+
+// Adds a chat message to a game and sends it to every client
+func (h *hub) say(c *client, text string) {
+	m := chatMessage{Name: c.name, Player: c.player, Text: text, Time: time.Now()}
+
+	h.mu.Lock()
+	h.chat[c.game] = append(h.chat[c.game], m)
+	if len(h.chat[c.game]) > CHAT_HISTORY {
+		h.chat[c.game] = h.chat[c.game][1:]
+	}
+	h.mu.Unlock()
+
+	h.broadcast(c.game, event{Type: "chat", Chat: &m})
+}
+
+// Checks that a WebSocket is opened from the web board, at its public URL or the host the request was sent to.
+// Requests without an origin don't come from a browser, they can't act for a visitor of another site and are allowed
+func (h *hub) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	return h.public != nil && strings.EqualFold(u.Scheme, h.public.Scheme) && strings.EqualFold(u.Host, h.public.Host)
+}
+
+// Cuts a text down to a number of characters
+func clamp(text string, length int) string {
+	if utf8.RuneCountInString(text) > length {
+		return string([]rune(text)[:length])
+	}
+	return text
+}
+
+// Upgrades a request to a WebSocket and sends the game, its clocks and chat until the client leaves.
+// Players chat under their name, spectators have to give one with the name parameter to chat
+func (h *hub) serve(w http.ResponseWriter, r *http.Request, g games.Game, token string) {
+	upgrader := websocket.Upgrader{CheckOrigin: h.checkOrigin}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	c := &client{game: g.ID, token: token, send: make(chan event, SEND_BUFFER)}
+	if c.player = g.PlayerWithToken(token); c.player != 0 {
+		c.name = nameOf(g, c.player, 0)
+	} else if name := strings.TrimSpace(r.URL.Query().Get("name")); name != "" {
+		c.name = clamp(name, games.MAX_NAME) + " (spectator)"
+	}
+
+	// Start with the current state so the client doesn't have to ask for it
+	v := viewOf(g, token)
+	c.send <- event{Type: "position", Game: &v}
+	c.send <- clockEvent(g, time.Now())
+	h.mu.Lock()
+	for _, m := range h.chat[g.ID] {
+		m := m
+		select {
+		case c.send <- event{Type: "chat", Chat: &m}:
+		default:
+		}
+	}
+	if h.clients[g.ID] == nil {
+		h.clients[g.ID] = make(map[*client]bool)
+	}
+	h.clients[g.ID][c] = true
+	h.mu.Unlock()
+
+	go c.write(conn)
+	c.read(h, conn)
+}
+
+// Sends events to the client until it is removed from the hub
+func (c *client) write(conn *websocket.Conn) {
+	defer conn.Close()
+	for e := range c.send {
+		conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+		if err := conn.WriteJSON(e); err != nil {
+			return
+		}
+	}
+	conn.WriteMessage(websocket.CloseMessage, []byte{})
+}
+
+// Reads chat messages from the client until it disconnects
+func (c *client) read(h *hub, conn *websocket.Conn) {
+	defer func() {
+		h.mu.Lock()
+		h.remove(c)
+		h.mu.Unlock()
+	}()
+
+	conn.SetReadLimit(MESSAGE_LENGTH)
+	for {
+		var m clientMessage
+		if err := conn.ReadJSON(&m); err != nil {
+			return
+		}
+		if m.Type != "chat" {
+			continue
+		}
+
+		text := clamp(strings.TrimSpace(m.Text), CHAT_LENGTH)
+		switch {
+		case text == "":
+		case c.name == "":
+			c.refuse(h, "Add a name to chat as a spectator")
+		case !c.throttle(time.Now()):
+			c.refuse(h, "Too many messages, wait a moment before chatting again")
+		default:
+			h.say(c, text)
+		}
+	}
+}
+
+// Counts a chat message sent by the client, returns false if it already sent CHAT_LIMIT in the window.
+// Only the goroutine reading from the client uses it
+func (c *client) throttle(now time.Time) bool {
+	since := now.Add(-CHAT_WINDOW)
+	for len(c.said) > 0 && c.said[0].Before(since) {
+		c.said = c.said[1:]
+	}
+	if len(c.said) >= CHAT_LIMIT {
+		return false
+	}
+	c.said = append(c.said, now)
+	return true
+}
+
+// Tells the client why its chat message was refused
+func (c *client) refuse(h *hub, reason string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.clients[c.game][c] {
+		select {
+		case c.send <- event{Type: "chat", Error: reason}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package web
+
+import (
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+//go:embed static
+var static embed.FS // The web board, built into the binary so it can't get out of sync with the API
+
+// The name spectators see for Discord players
+const DISCORD_NAME = "Discord player"
+
+// Serves the REST API for games and the web board
+type Server struct {
+	games   *games.Service
+	hub     *hub
+	mux     *http.ServeMux
+	creates *limiter // Limits how many games each address creates
+}
+
+// A game as it is sent to the browser, tokens are only sent to whoever creates a game
+type gameView struct {
+	ID       string           `json:"id"`
+	Variant  string           `json:"variant"`
+	Title    string           `json:"title"`
+	Size     uint8            `json:"size"`
+	Rotated  bool             `json:"rotated"`
+	White    uint8            `json:"white"`    // The player starting on the highest numbered squares
+	Board    string           `json:"board"`    // Every square from square 1, 0 for empty, 1 and 2 for men, 3 and 4 for kings
+	Turn     uint8            `json:"turn"`     // Which players turn it is(1 or 2)
+	Players  map[uint8]string `json:"players"`  // Names of the players
+	Moves    []string         `json:"moves"`    // Every move made so far
+	Legal    []string         `json:"legal"`    // The moves the player to move can make
+	FEN      string           `json:"fen"`      // The current position
+	Winner   uint8            `json:"winner"`   // The player who won, 0 while the game is being played
+	Resigned bool             `json:"resigned"` // If the loser resigned
+	TimedOut bool             `json:"timedOut"` // If the loser ran out of time
+	Time     string           `json:"time"`     // The time control as minutes+increment, - for no time limit
+	You      uint8            `json:"you"`      // The player the token sent with the request belongs to
+	Tokens   map[uint8]string `json:"tokens,omitempty"`
+	Updated  time.Time        `json:"updated"`
+}
+
+// The body of a request to create a game
+type createRequest struct {
+	Variant string           `json:"variant"`
+	FEN     string           `json:"fen"`
+	Players map[uint8]string `json:"players"`
+	Time    string           `json:"time"` // Minutes+increment like 10+5, empty for no time limit
+}
+
+// The body of a request to make a move
+type moveRequest struct {
+	Move string `json:"move"`
+}
+
+// Creates a server for the games of a game service, browsers can only watch games live from the web board at its public URL
+func New(g *games.Service, publicURL string) *Server {
+	public, err := url.Parse(publicURL)
+	if err != nil || public.Host == "" {
+		public = nil
+	}
+	s := &Server{games: g, hub: newHub(g, public), mux: http.NewServeMux(), creates: newLimiter()}
+
+	files, _ := fs.Sub(static, "static")
+	s.mux.Handle("/", http.FileServer(http.FS(files)))
+	s.mux.HandleFunc("/api/games", s.gamesHandler)
+	s.mux.HandleFunc("/api/games/", s.gameHandler)
+	s.mux.HandleFunc("/board.png", s.boardHandler)
+	return s
+}
+
+// Handles every request, bodies larger than MAX_BODY are cut off
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, MAX_BODY)
+	s.mux.ServeHTTP(w, r)
+}
+
+// Creates the view of a game for the holder of a token
+func viewOf(g games.Game, token string) gameView {
+	position := g.Position()
+	r := position.Rules()
+
+	// Boards are kept as seen by the player to move, the browser always gets it as seen by white
+	board := g.Board
+	if g.Turn != logic.White(r) {
+		b := []byte(board)
+		for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+			b[i], b[j] = b[j], b[i]
+		}
+		board = string(b)
+	}
+
+	v := gameView{
+		ID:       g.ID,
+		Variant:  r.Name(),
+		Title:    r.Title(),
+		Size:     r.Size(),
+		Rotated:  r.Rotated(),
+		White:    logic.White(r),
+		Board:    board,
+		Turn:     g.Turn,
+		Players:  make(map[uint8]string),
+		Moves:    g.Moves,
+		Legal:    []string{},
+		FEN:      logic.FormatFEN(&position),
+		Winner:   g.Winner,
+		Resigned: g.Resigned,
+		TimedOut: g.TimedOut,
+		Time:     g.TimeControl.String(),
+		You:      g.PlayerWithToken(token),
+		Updated:  g.Updated,
+	}
+	if v.Moves == nil {
+		v.Moves = []string{}
+	}
+	for number := range g.Players {
+		v.Players[number] = nameOf(g, number, v.You)
+	}
+	if !g.Over() {
+		for _, seq := range logic.GetSequences(&position) {
+			v.Legal = append(v.Legal, logic.FormatSequence(seq, &position))
+		}
+	}
+	return v
+}
+
+// Checks if a game is in the list of games, games with Discord players are only found through the links sent to them
+func listed(g games.Game) bool {
+	for _, p := range g.Players {
+		if p.DiscordID != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Gets the name of a player shown to someone watching as a player, 0 for spectators.
+// Discord players are only named to the players of their game so spectators can't find their account
+func nameOf(g games.Game, number uint8, viewer uint8) string {
+	if p := g.Players[number]; p.DiscordID == "" || viewer != 0 {
+		return p.Name
+	}
+	return DISCORD_NAME
+}
+
+// Gets the token sent with a request, either as a bearer token or in the query
+func tokenOf(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimPrefix(auth, "Bearer ")
+	}
+	return r.URL.Query().Get("token")
+}
+
+// Sends a value as JSON
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// Sends an error as JSON
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+// Handles listing and creating games
+func (s *Server) gamesHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		views := []gameView{}
+		for _, g := range s.games.Store.List() {
+			if listed(g) {
+				views = append(views, viewOf(g, ""))
+			}
+		}
+		writeJSON(w, http.StatusOK, views)
+	case http.MethodPost:
+		var req createRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+
+		rules := logic.Variants[0]
+		if req.Variant != "" {
+			var err error
+			if rules, err = logic.GetRules(req.Variant); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		start := logic.NewGame(rules)
+		if req.FEN != "" {
+			var err error
+			if start, err = logic.ParseFEN(req.FEN, rules); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		control, err := games.ParseTimeControl(req.Time)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Only games that were created count towards the limit, so invalid requests can't use it up
+		if !s.creates.allow(r) {
+			writeError(w, http.StatusTooManyRequests, "Too many games created, try again later")
+			return
+		}
+		players := map[uint8]games.Player{1: {Name: req.Players[1]}, 2: {Name: req.Players[2]}}
+		g, err := s.games.Create(start, players, nil, false, control, games.SOURCE_WEB, nil)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.creates.count(r)
+
+		// Whoever creates a game gets both tokens so they can share one with their opponent
+		v := viewOf(g, "")
+		v.Tokens = map[uint8]string{1: g.Players[1].Token, 2: g.Players[2].Token}
+		writeJSON(w, http.StatusCreated, v)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// Handles getting a game, watching it live, making moves and resigning
+func (s *Server) gameHandler(w http.ResponseWriter, r *http.Request) {
+	// Paths look like /api/games/<id> or /api/games/<id>/<action>
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/games/"), "/"), "/")
+	g, ok := s.games.Store.Get(parts[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, "Game not found")
+		return
+	}
+	token := tokenOf(r)
+
+	action := ""
+	if len(parts) > 1 {
+		action = parts[1]
+	}
+	if (action == "" || action == "live") != (r.Method == http.MethodGet) || len(parts) > 2 {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var err error
+	switch action {
+	case "":
+		writeJSON(w, http.StatusOK, viewOf(g, token))
+		return
+	case "live":
+		s.hub.serve(w, r, g, token)
+		return
+	case "moves":
+		var req moveRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Move == "" {
+			writeError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+		player := g.PlayerWithToken(token)
+		if player == 0 {
+			writeError(w, http.StatusForbidden, "You are not playing in this game")
+			return
+		}
+		g, err = s.games.Move(g.ID, player, req.Move, games.SOURCE_WEB, nil)
+	case "resign":
+		player := g.PlayerWithToken(token)
+		if player == 0 {
+			writeError(w, http.StatusForbidden, "You are not playing in this game")
+			return
+		}
+		g, err = s.games.Resign(g.ID, player, games.SOURCE_WEB, nil)
+	default:
+		writeError(w, http.StatusNotFound, "Unknown action")
+		return
+	}
+
+	if err != nil {
+		writeError(w, http.StatusConflict, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, viewOf(g, token))
+}
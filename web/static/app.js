@@ -0,0 +1,342 @@
+"use strict";
+
+// Players, the same numbers the bot uses
+const BLUE = 1;
+const RED = 2;
+const NAMES = { [BLUE]: "Blue", [RED]: "Red" };
+const VARIANTS = ["american", "international", "russian", "brazilian", "italian", "spanish", "giveaway"];
+
+const app = document.getElementById("app");
+let current = null; // The game being shown
+let selected = 0; // The square number of the selected piece
+let socket = null; // Sends the game, its clocks and chat as they change
+let clocks = {}; // Milliseconds each player has spent thinking, or has left in timed games
+
+// Escapes text before putting it in HTML
+function escape(text) {
+	const div = document.createElement("div");
+	div.textContent = text;
+	return div.innerHTML;
+}
+
+// Sends a request to the API and throws the error it answers with
+async function api(method, path, body, token) {
+	const headers = { "Content-Type": "application/json" };
+	if (token) {
+		headers.Authorization = "Bearer " + token;
+	}
+	const res = await fetch("/api" + path, { method, headers, body: body ? JSON.stringify(body) : undefined });
+	const data = await res.json();
+	if (!res.ok) {
+		throw new Error(data.error);
+	}
+	return data;
+}
+
+// Gets the token saved for a game, a token in the link is saved first
+function tokenFor(id) {
+	const params = new URLSearchParams(location.hash.split("?")[1]);
+	if (params.get("token")) {
+		localStorage.setItem("token:" + id, params.get("token"));
+		history.replaceState(null, "", "#/games/" + id);
+	}
+	return localStorage.getItem("token:" + id) || "";
+}
+
+// Describes the state of a game
+function status(game) {
+	if (game.winner) {
+		let how = NAMES[3 - game.winner] + " can't move";
+		if (game.resigned) {
+			how = NAMES[3 - game.winner] + " resigned";
+		} else if (game.timedOut) {
+			how = NAMES[3 - game.winner] + " ran out of time";
+		}
+		return NAMES[game.winner] + " (" + escape(game.players[game.winner]) + ") wins, " + how + ".";
+	}
+	return NAMES[game.turn] + " (" + escape(game.players[game.turn]) + ") to move.";
+}
+
+// Shows every game and a form to create one
+async function showList() {
+	current = null;
+	let games = [];
+	try {
+		games = await api("GET", "/games");
+	} catch (e) {
+		app.innerHTML = `<p class="error">${escape(e.message)}</p>`;
+		return;
+	}
+
+	const rows = games.map(g => `<tr>
+		<td><a href="#/games/${g.id}">${escape(g.players[BLUE])} vs ${escape(g.players[RED])}</a></td>
+		<td>${escape(g.title)}</td>
+		<td>${g.moves.length}</td>
+		<td>${status(g)}</td>
+	</tr>`).join("");
+
+	app.innerHTML = `
+		<h2>New game</h2>
+		<form id="create">
+			<input name="blue" placeholder="Blue player" required>
+			<input name="red" placeholder="Red player" required>
+			<select name="variant">${VARIANTS.map(v => `<option>${v}</option>`).join("")}</select>
+			<input name="fen" placeholder="Starting position (FEN, optional)">
+			<input name="time" placeholder="Time control like 10+5 (optional)">
+			<button>Create</button>
+		</form>
+		<div id="created"></div>
+		<h2>Games</h2>
+		<table><tr><th>Players</th><th>Variant</th><th>Moves</th><th>Status</th></tr>${rows}</table>`;
+
+	document.getElementById("create").onsubmit = async event => {
+		event.preventDefault();
+		const form = new FormData(event.target);
+		try {
+			const game = await api("POST", "/games", {
+				variant: form.get("variant"),
+				fen: form.get("fen"),
+				time: form.get("time"),
+				players: { [BLUE]: form.get("blue"), [RED]: form.get("red") },
+			});
+			const link = player => `${location.origin}${location.pathname}#/games/${game.id}?token=${game.tokens[player]}`;
+			document.getElementById("created").innerHTML = `
+				<p>Share each link with the player it belongs to, anyone with a link can move for that player.</p>
+				<p>${NAMES[BLUE]}: <a href="${link(BLUE)}">${link(BLUE)}</a></p>
+				<p>${NAMES[RED]}: <a href="${link(RED)}">${link(RED)}</a></p>`;
+		} catch (e) {
+			document.getElementById("created").innerHTML = `<p class="error">${escape(e.message)}</p>`;
+		}
+	};
+}
+
+// Gets the squares a move goes through
+function squaresOf(move) {
+	return move.split(/[-x]/).map(Number);
+}
+
+// Draws a game
+function render(game, error) {
+	const width = game.size / 2;
+	const flipped = game.you !== 0 && game.you !== game.white;
+	const last = game.moves.length ? squaresOf(game.moves[game.moves.length - 1]) : [];
+	const mine = game.you === game.turn && !game.winner;
+	const targets = game.legal.filter(m => squaresOf(m)[0] === selected).map(m => squaresOf(m).pop());
+
+	let squares = "";
+	for (let row = 0; row < game.size; row++) {
+		for (let col = 0; col < game.size; col++) {
+			// The board is drawn as seen by white and turned around for the other player
+			const r = flipped ? game.size - 1 - row : row;
+			const c = flipped ? game.size - 1 - col : col;
+			const dark = (r % 2 === 0) !== game.rotated ? c % 2 === 1 : c % 2 === 0;
+			if (!dark) {
+				squares += `<div class="square light"></div>`;
+				continue;
+			}
+
+			const number = r * width + Math.floor(c / 2) + 1;
+			const piece = game.board[number - 1];
+			const classes = ["square", "dark"];
+			if (number === selected) classes.push("selected");
+			if (targets.includes(number)) classes.push("target");
+			if (last.includes(number)) classes.push("last");
+
+			let content = `<span class="number">${number}</span>`;
+			if (piece !== "0") {
+				const player = piece === "1" || piece === "3" ? BLUE : RED;
+				content += `<div class="piece p${player}">${piece === "3" || piece === "4" ? "♔" : ""}</div>`;
+			}
+			squares += `<div class="${classes.join(" ")}" data-square="${number}">${content}</div>`;
+		}
+	}
+
+	const you = game.you ? `You are playing as ${NAMES[game.you]}.` : "You are watching this game.";
+	const view = document.getElementById("view");
+	view.innerHTML = `
+		<h2>${escape(game.players[BLUE])} vs ${escape(game.players[RED])} - ${escape(game.title)}</h2>
+		<div class="game">
+			<div class="board" style="grid-template-columns: repeat(${game.size}, 1fr)">${squares}</div>
+			<div class="side">
+				<p>${status(game)}</p>
+				<p>${NAMES[BLUE]}: <span id="clock${BLUE}"></span> ${NAMES[RED]}: <span id="clock${RED}"></span></p>
+				<p>${you}</p>
+				<p class="error">${error ? escape(error) : ""}</p>
+				${mine ? `<form id="notation"><input name="move" placeholder="Move, for example 11-15"><button>Play</button></form>` : ""}
+				${game.you && !game.winner ? `<button id="resign">Resign</button>` : ""}
+				<h3>Moves</h3>
+				<div class="moves">${game.moves.map((m, i) => (i % 2 === 0 ? `${i / 2 + 1}. ` : "") + m).join(" ")}</div>
+				<h3>Position</h3>
+				<code>${escape(game.fen)}</code>
+			</div>
+		</div>`;
+
+	showClocks();
+	if (mine) {
+		for (const square of view.querySelectorAll("[data-square]")) {
+			square.onclick = () => clickSquare(Number(square.dataset.square));
+		}
+		document.getElementById("notation").onsubmit = event => {
+			event.preventDefault();
+			play(new FormData(event.target).get("move"));
+		};
+	}
+	if (game.you && !game.winner) {
+		document.getElementById("resign").onclick = async () => {
+			if (!confirm("Are you sure you want to resign?")) return;
+			try {
+				current = await api("POST", `/games/${current.id}/resign`, {}, tokenFor(current.id));
+				render(current);
+			} catch (e) {
+				render(current, e.message);
+			}
+		};
+	}
+}
+
+// Selects a piece or moves the selected piece
+function clickSquare(number) {
+	const moves = current.legal.filter(m => squaresOf(m)[0] === selected && squaresOf(m).pop() === number);
+	if (selected && moves.length) {
+		// Different captures can end on the same square, then the player has to pick one
+		if (moves.length > 1) {
+			const move = prompt("More than one capture ends on that square, which one do you want to play?\n" + moves.join("\n"), moves[0]);
+			if (move) play(move);
+			return;
+		}
+		play(moves[0]);
+		return;
+	}
+
+	selected = current.legal.some(m => squaresOf(m)[0] === number) ? number : 0;
+	render(current);
+}
+
+// Sends a move
+async function play(move) {
+	selected = 0;
+	try {
+		current = await api("POST", `/games/${current.id}/moves`, { move }, tokenFor(current.id));
+		render(current);
+	} catch (e) {
+		render(current, e.message);
+	}
+}
+
+// Formats milliseconds as minutes and seconds
+function formatClock(ms) {
+	const seconds = Math.floor(ms / 1000);
+	return Math.floor(seconds / 60) + ":" + String(seconds % 60).padStart(2, "0");
+}
+
+// Shows the time each player has spent thinking, or has left in timed games
+function showClocks() {
+	for (const player of [BLUE, RED]) {
+		const clock = document.getElementById("clock" + player);
+		if (clock) {
+			clock.textContent = formatClock(clocks[player] || 0);
+		}
+	}
+}
+
+// Adds a chat message to the chat
+function showChat(message, error) {
+	const chat = document.getElementById("messages");
+	const line = document.createElement("div");
+	if (error) {
+		line.className = "error";
+		line.textContent = error;
+	} else {
+		const who = message.player ? NAMES[message.player] + " " : "";
+		line.textContent = `${who}${message.name}: ${message.text}`;
+	}
+	chat.appendChild(line);
+	chat.scrollTop = chat.scrollHeight;
+}
+
+// Shows a game and keeps it up to date as moves are made, on Discord or here
+function showGame(id) {
+	selected = 0;
+	current = null;
+	clocks = {};
+	const token = tokenFor(id);
+	const name = localStorage.getItem("name") || "";
+	app.innerHTML = `
+		<div id="view"></div>
+		<h3>Chat</h3>
+		<div id="messages" class="moves"></div>
+		<form id="chat">
+			${token ? "" : `<input name="name" placeholder="Your name" value="${escape(name)}">`}
+			<input name="text" placeholder="Say something" autocomplete="off">
+			<button>Send</button>
+		</form>`;
+
+	let pending = null; // A chat message waiting for the connection to open
+	const connect = () => {
+		const params = new URLSearchParams({ token, name: localStorage.getItem("name") || "" });
+		const protocol = location.protocol === "https:" ? "wss:" : "ws:";
+		const ws = new WebSocket(`${protocol}//${location.host}/api/games/${id}/live?${params}`);
+		socket = ws;
+		ws.onopen = () => {
+			if (pending) {
+				ws.send(JSON.stringify({ type: "chat", text: pending }));
+				pending = null;
+			}
+		};
+		ws.onmessage = message => {
+			const e = JSON.parse(message.data);
+			if (e.type === "position") {
+				selected = 0;
+				current = e.game;
+				render(current);
+			} else if (e.type === "clock") {
+				clocks = e.clocks;
+				showClocks();
+			} else if (e.type === "chat") {
+				showChat(e.chat, e.error);
+			}
+		};
+		// Reconnect unless another game was opened
+		ws.onclose = () => {
+			if (socket === ws) {
+				setTimeout(() => socket === ws && connect(), 2000);
+			}
+		};
+	};
+	api("GET", `/games/${id}`).then(connect, e => {
+		app.innerHTML = `<p class="error">${escape(e.message)}</p>`;
+	});
+
+	document.getElementById("chat").onsubmit = event => {
+		event.preventDefault();
+		const form = new FormData(event.target);
+		// Spectators pick a name once and it is used from then on
+		if (form.get("name") !== null && form.get("name") !== (localStorage.getItem("name") || "")) {
+			localStorage.setItem("name", form.get("name"));
+			pending = form.get("text");
+			socket.close();
+			connect();
+		} else {
+			socket.send(JSON.stringify({ type: "chat", text: form.get("text") }));
+		}
+		event.target.elements.text.value = "";
+	};
+}
+
+// Shows the page for the current link
+function route() {
+	if (socket) {
+		const old = socket;
+		socket = null;
+		old.close();
+	}
+	const match = location.hash.match(/^#\/games\/([0-9a-f]+)/);
+	if (match) {
+		showGame(match[1]);
+	} else {
+		showList();
+	}
+}
+
+window.addEventListener("hashchange", route);
+route();
@@ -0,0 +1,118 @@
+package webhooks
+
+import (
+	"github.com/jmsheff/discord-checkers/games"
+	"github.com/jmsheff/discord-checkers/logic"
+)
+
+// A player as it is sent in events
+type Player struct {
+	DiscordID string `json:"discord_id,omitempty"` // Empty for players who only play on the web
+	Name      string `json:"name"`
+	Color     string `json:"color,omitempty"` // red or blue
+}
+
+// Sent when an invite to a game is sent on Discord
+type InviteSent struct {
+	GuildID   string  `json:"guild_id,omitempty"`
+	ChannelID string  `json:"channel_id"`
+	From      Player  `json:"from"`
+	To        *Player `json:"to,omitempty"` // Empty for invites anyone in the channel can accept
+	Variant   string  `json:"variant"`
+	Ballot    string  `json:"ballot,omitempty"` // The opening ballot the game starts from
+	Casual    bool    `json:"casual"`
+}
+
+// Sent when a game starts
+type GameStarted struct {
+	GameID  string   `json:"game_id"`
+	Variant string   `json:"variant"`
+	Players []Player `json:"players"`
+	Start   string   `json:"start"` // The starting position as FEN
+	Moves   []string `json:"moves"` // Moves already played, like a ballot
+	Casual  bool     `json:"casual"`
+	Source  string   `json:"source"` // discord or web
+}
+
+// Sent after every move
+type MoveMade struct {
+	GameID string `json:"game_id"`
+	Player Player `json:"player"`
+	Move   string `json:"move"`
+	Ply    int    `json:"ply"` // Number of the move counting both players, starting from 1
+	FEN    string `json:"fen"` // The position after the move
+	Source string `json:"source"`
+}
+
+// Sent when a game ends
+type GameFinished struct {
+	GameID   string `json:"game_id"`
+	Winner   Player `json:"winner"`
+	Loser    Player `json:"loser"`
+	Resigned bool   `json:"resigned"`
+	Reason   string `json:"reason"` // no_moves, no_pieces, resigned or timeout
+	Result   string `json:"result"` // The result as written in PDN
+	PDN      string `json:"pdn"`
+	Source   string `json:"source"`
+}
+
+// Gets the name of a players color
+func colorName(player uint8) string {
+	if player == 2 {
+		return "red"
+	}
+	return "blue"
+}
+
+// Gets a player of a game as it is sent in events
+func playerOf(g games.Game, number uint8) Player {
+	p := g.Players[number]
+	return Player{DiscordID: p.DiscordID, Name: p.Name, Color: colorName(number)}
+}
+
+// Sends an event for every game that is saved when it starts, after every move and when it ends
+func Watch(d *Dispatcher, bus *games.Bus) {
+	bus.SubscribeAsync(func(e games.Event) {
+		switch e := e.(type) {
+		case games.GameStarted:
+			game := e.Game
+			d.Send(GAME_STARTED, GameStarted{
+				GameID:  game.ID,
+				Variant: game.Variant,
+				Players: []Player{playerOf(game, 1), playerOf(game, 2)},
+				Start:   game.Start,
+				Moves:   game.Moves,
+				Casual:  game.Casual,
+				Source:  e.Source,
+			})
+		case games.MoveApplied:
+			if e.Game.ID == "" {
+				return
+			}
+			position := e.Game.Position()
+			d.Send(MOVE_MADE, MoveMade{
+				GameID: e.Game.ID,
+				Player: playerOf(e.Game, e.Player),
+				Move:   e.Move,
+				Ply:    len(e.Game.Moves),
+				FEN:    logic.FormatFEN(&position),
+				Source: e.Source,
+			})
+		case games.GameWon:
+			if e.Game.ID == "" {
+				return
+			}
+			record := e.Game.PDN()
+			d.Send(GAME_FINISHED, GameFinished{
+				GameID:   e.Game.ID,
+				Winner:   playerOf(e.Game, e.Winner),
+				Loser:    playerOf(e.Game, 3-e.Winner),
+				Resigned: e.Reason == games.RESIGNED,
+				Reason:   e.Reason,
+				Result:   record.Result,
+				PDN:      record.String(),
+				Source:   e.Source,
+			})
+		}
+	})
+}
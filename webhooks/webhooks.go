@@ -0,0 +1,264 @@
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/storage"
+)
+
+// Types of events
+const (
+	INVITE_SENT   = "invite_sent"
+	GAME_STARTED  = "game_started"
+	MOVE_MADE     = "move_made"
+	GAME_FINISHED = "game_finished"
+)
+
+// The bucket events waiting to be delivered are saved in
+const BUCKET = "webhooks"
+
+// How many times an event is sent before giving up on it
+const MAX_ATTEMPTS = 10
+
+// How long to wait before the first retry, the wait doubles after every failed attempt
+const FIRST_RETRY = 5 * time.Second
+
+// The longest to wait between retries
+const MAX_RETRY = time.Hour
+
+// Headers sent with every event
+const (
+	EVENT_HEADER     = "X-Checkers-Event"
+	DELIVERY_HEADER  = "X-Checkers-Delivery"
+	SIGNATURE_HEADER = "X-Checkers-Signature"
+)
+
+// A URL events are sent to
+type Endpoint struct {
+	URL    string // Where events are posted
+	Secret string // Key the body of every event is signed with, empty to not sign events
+}
+
+// An event as it is sent
+type Event struct {
+	ID   string      `json:"id"`
+	Type string      `json:"type"`
+	Time time.Time   `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+// An event waiting to be sent to an endpoint, kept in the store until it is delivered
+type delivery struct {
+	ID       string          // Unique for every endpoint an event is sent to
+	Type     string          // Type of the event
+	URL      string          // Where the event is sent
+	Body     json.RawMessage // The event, kept exactly as it was signed
+	Attempts int             // How many times sending it failed
+	Next     time.Time       // When to try sending it next
+}
+
+// Sends events to every endpoint, retrying failed deliveries until they go through.
+// Every endpoint is sent to on its own so one that is down doesn't hold up the others
+type Dispatcher struct {
+	store      *storage.Store
+	endpoints  []Endpoint
+	client     *http.Client
+	firstRetry time.Duration // Wait before the first retry, FIRST_RETRY unless a test shortens it
+	mu         sync.Mutex
+	wake       map[string]chan struct{} // Wakes the sender of an endpoint by its URL when it has a new delivery
+}
+
+// Parses a comma separated list of URLs that share a secret
+func ParseEndpoints(urls string, secret string) []Endpoint {
+	var endpoints []Endpoint
+	for _, url := range strings.Split(urls, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			endpoints = append(endpoints, Endpoint{URL: url, Secret: secret})
+		}
+	}
+	return endpoints
+}
+
+// Creates a dispatcher that keeps its outbox in a store, deliveries left from before a restart are sent once it starts
+func New(s *storage.Store, endpoints []Endpoint) *Dispatcher {
+	d := &Dispatcher{
+		store:      s,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		firstRetry: FIRST_RETRY,
+		wake:       make(map[string]chan struct{}),
+	}
+
+	// An endpoint listed twice would get every event twice
+	for _, endpoint := range endpoints {
+		if _, ok := d.wake[endpoint.URL]; !ok {
+			d.endpoints = append(d.endpoints, endpoint)
+			d.wake[endpoint.URL] = make(chan struct{}, 1)
+		}
+	}
+	return d
+}
+
+// Starts sending events to every endpoint in the background
+func (d *Dispatcher) Start() {
+	// Deliveries to endpoints that were removed since they were queued can't be sent anymore
+	for _, id := range d.store.Keys(BUCKET) {
+		var del delivery
+		if d.store.Get(BUCKET, id, &del) && d.wake[del.URL] == nil {
+			d.remove(del)
+		}
+	}
+
+	for _, endpoint := range d.endpoints {
+		go d.run(endpoint)
+	}
+}
+
+// Signs a body with a secret, the signature is sent as sha256=<hex>
+func Sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Checks a signature sent with an event, for receivers written in Go
+func Verify(body []byte, secret string, signature string) bool {
+	return hmac.Equal([]byte(Sign(body, secret)), []byte(signature))
+}
+
+// Generates a random ID
+func randomID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Queues an event for every endpoint, does nothing on a nil dispatcher so callers don't have to check if webhooks are set up
+func (d *Dispatcher) Send(eventType string, data interface{}) {
+	if d == nil || len(d.endpoints) == 0 {
+		return
+	}
+
+	e := Event{ID: randomID(), Type: eventType, Time: time.Now().UTC(), Data: data}
+	body, err := json.Marshal(e)
+	if err != nil {
+		slog.Error("Could not encode webhook event", "event", eventType, "err", err)
+		return
+	}
+
+	// Deliveries are sent in the order of their IDs so they start with the time
+	d.mu.Lock()
+	for _, endpoint := range d.endpoints {
+		id := fmt.Sprintf("%020d-%s", time.Now().UnixNano(), randomID())
+		del := delivery{ID: id, Type: eventType, URL: endpoint.URL, Body: body, Next: e.Time}
+		if err := d.store.Put(BUCKET, del.ID, del); err != nil {
+			slog.Error("Could not save webhook event", "event", eventType, "url", endpoint.URL, "err", err)
+		}
+	}
+	d.mu.Unlock()
+
+	for _, wake := range d.wake {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Sends the deliveries of an endpoint in order as they become due until the program stops
+func (d *Dispatcher) run(endpoint Endpoint) {
+	for {
+		now := time.Now()
+		next := now.Add(MAX_RETRY)
+		for _, id := range d.store.Keys(BUCKET) {
+			var del delivery
+			if !d.store.Get(BUCKET, id, &del) || del.URL != endpoint.URL {
+				continue
+			}
+			if del.Next.After(now) {
+				if del.Next.Before(next) {
+					next = del.Next
+				}
+				continue
+			}
+
+			if d.deliver(endpoint, del) {
+				continue
+			}
+			if retry := d.retry(del); !retry.IsZero() && retry.Before(next) {
+				next = retry
+			}
+		}
+
+		select {
+		case <-d.wake[endpoint.URL]:
+		case <-time.After(time.Until(next)):
+		}
+	}
+}
+
+// Posts a delivery to its endpoint, returns true once it is done with, either delivered or impossible to send
+func (d *Dispatcher) deliver(endpoint Endpoint, del delivery) bool {
+	req, err := http.NewRequest(http.MethodPost, del.URL, bytes.NewReader(del.Body))
+	if err != nil {
+		slog.Error("Invalid webhook URL", "event", del.Type, "url", del.URL, "err", err)
+		d.remove(del)
+		return true
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EVENT_HEADER, del.Type)
+	req.Header.Set(DELIVERY_HEADER, del.ID)
+	if endpoint.Secret != "" {
+		req.Header.Set(SIGNATURE_HEADER, Sign(del.Body, endpoint.Secret))
+	}
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return false
+	}
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return false
+	}
+
+	d.remove(del)
+	return true
+}
+
+// Removes a delivery from the outbox, one that can't be removed is sent again after a restart
+func (d *Dispatcher) remove(del delivery) {
+	if err := d.store.Delete(BUCKET, del.ID); err != nil {
+		slog.Error("Could not remove webhook event", "event", del.Type, "url", del.URL, "attempts", del.Attempts, "err", err)
+	}
+}
+
+// Schedules the next try of a failed delivery, returns when it is or a zero time if the delivery was dropped
+func (d *Dispatcher) retry(del delivery) time.Time {
+	del.Attempts++
+	if del.Attempts >= MAX_ATTEMPTS {
+		slog.Error("Giving up on webhook event", "event", del.Type, "url", del.URL, "attempts", del.Attempts)
+		d.remove(del)
+		return time.Time{}
+	}
+
+	wait := d.firstRetry << uint(del.Attempts-1)
+	if wait > MAX_RETRY || wait <= 0 {
+		wait = MAX_RETRY
+	}
+	del.Next = time.Now().Add(wait)
+	if err := d.store.Put(BUCKET, del.ID, del); err != nil {
+		// The retry still happens at the right time, the attempt just isn't counted after a restart
+		slog.Error("Could not save webhook retry", "event", del.Type, "url", del.URL, "attempts", del.Attempts, "err", err)
+	}
+	return del.Next
+}
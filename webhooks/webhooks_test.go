@@ -0,0 +1,179 @@
+package webhooks
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmsheff/discord-checkers/storage"
+)
+
+// A request received by a stub endpoint
+type received struct {
+	Header http.Header
+	Body   []byte
+	Time   time.Time
+}
+
+// Starts an endpoint that answers with the given statuses in order, then with 200, and records every request
+func stub(t *testing.T, statuses ...int) (*httptest.Server, chan received) {
+	requests := make(chan received, 100)
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- received{Header: r.Header, Body: body, Time: time.Now()}
+
+		mu.Lock()
+		status := http.StatusOK
+		if len(statuses) != 0 {
+			status, statuses = statuses[0], statuses[1:]
+		}
+		mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+// Waits for the next request of a stub endpoint
+func next(t *testing.T, requests chan received) received {
+	t.Helper()
+	select {
+	case r := <-requests:
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a delivery")
+		return received{}
+	}
+}
+
+// Waits until every delivery is gone from the outbox
+func waitEmpty(t *testing.T, s *storage.Store) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for len(s.Keys(BUCKET)) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d deliveries left in the outbox", len(s.Keys(BUCKET)))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSignedDelivery(t *testing.T) {
+	server, requests := stub(t)
+	s, _ := storage.Open("")
+	d := New(s, []Endpoint{{URL: server.URL, Secret: "secret"}})
+	d.Start()
+
+	d.Send(GAME_STARTED, map[string]string{"game_id": "1"})
+	r := next(t, requests)
+	if r.Header.Get(EVENT_HEADER) != GAME_STARTED {
+		t.Errorf("Event header is %q", r.Header.Get(EVENT_HEADER))
+	}
+	if r.Header.Get(DELIVERY_HEADER) == "" {
+		t.Error("Missing delivery header")
+	}
+	if !Verify(r.Body, "secret", r.Header.Get(SIGNATURE_HEADER)) {
+		t.Errorf("Signature %q doesn't match the body", r.Header.Get(SIGNATURE_HEADER))
+	}
+	if Verify(r.Body, "other", r.Header.Get(SIGNATURE_HEADER)) {
+		t.Error("Signature matches another secret")
+	}
+	waitEmpty(t, s)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	server, requests := stub(t, http.StatusInternalServerError, http.StatusBadGateway)
+	s, _ := storage.Open("")
+	d := New(s, []Endpoint{{URL: server.URL}})
+	d.firstRetry = 50 * time.Millisecond
+	d.Start()
+
+	d.Send(MOVE_MADE, map[string]string{"game_id": "1"})
+	first, second, third := next(t, requests), next(t, requests), next(t, requests)
+	if first.Header.Get(DELIVERY_HEADER) != third.Header.Get(DELIVERY_HEADER) {
+		t.Error("A retry was sent as a new delivery")
+	}
+	if wait := second.Time.Sub(first.Time); wait < d.firstRetry {
+		t.Errorf("First retry after %s, expected at least %s", wait, d.firstRetry)
+	}
+	if wait := third.Time.Sub(second.Time); wait < 2*d.firstRetry {
+		t.Errorf("Second retry after %s, expected at least %s", wait, 2*d.firstRetry)
+	}
+	waitEmpty(t, s)
+}
+
+func TestGivesUp(t *testing.T) {
+	statuses := make([]int, MAX_ATTEMPTS+1)
+	for i := range statuses {
+		statuses[i] = http.StatusInternalServerError
+	}
+	server, requests := stub(t, statuses...)
+	s, _ := storage.Open("")
+	d := New(s, []Endpoint{{URL: server.URL}})
+	d.firstRetry = time.Millisecond
+	d.Start()
+
+	d.Send(MOVE_MADE, map[string]string{"game_id": "1"})
+	for i := 0; i < MAX_ATTEMPTS; i++ {
+		next(t, requests)
+	}
+	waitEmpty(t, s)
+}
+
+func TestOutboxSurvivesRestart(t *testing.T) {
+	server, requests := stub(t)
+	path := filepath.Join(t.TempDir(), "data.json")
+
+	// The first dispatcher stops before it sends anything
+	s, err := storage.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	New(s, []Endpoint{{URL: server.URL}}).Send(GAME_FINISHED, map[string]string{"game_id": "1"})
+
+	s, err = storage.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Keys(BUCKET)) != 1 {
+		t.Fatalf("%d deliveries saved, expected 1", len(s.Keys(BUCKET)))
+	}
+	New(s, []Endpoint{{URL: server.URL}}).Start()
+	if r := next(t, requests); r.Header.Get(EVENT_HEADER) != GAME_FINISHED {
+		t.Errorf("Event header is %q", r.Header.Get(EVENT_HEADER))
+	}
+	waitEmpty(t, s)
+}
+
+func TestRemovedEndpointDropped(t *testing.T) {
+	s, _ := storage.Open("")
+	New(s, []Endpoint{{URL: "http://127.0.0.1:1/removed"}}).Send(GAME_FINISHED, nil)
+
+	server, _ := stub(t)
+	New(s, []Endpoint{{URL: server.URL}}).Start()
+	waitEmpty(t, s)
+}
+
+func TestSlowEndpointDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	defer close(release)
+	fast, requests := stub(t)
+
+	s, _ := storage.Open("")
+	d := New(s, []Endpoint{{URL: slow.URL}, {URL: fast.URL}})
+	d.Start()
+
+	d.Send(MOVE_MADE, map[string]string{"game_id": "1"})
+	d.Send(MOVE_MADE, map[string]string{"game_id": "2"})
+	next(t, requests)
+	next(t, requests)
+}